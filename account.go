@@ -1,6 +1,7 @@
 package apivideosdk
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -11,6 +12,7 @@ const accountBasePath = "account"
 // See: https://docs.api.video/5.1/captions
 type AccountServiceI interface {
 	Get() (*Account, error)
+	GetWithContext(ctx context.Context) (*Account, error)
 }
 
 // AccountService communicating with the Account
@@ -34,8 +36,13 @@ type Quota struct {
 
 //Get returns an Account
 func (s *AccountService) Get() (*Account, error) {
+	return s.GetWithContext(context.Background())
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *AccountService) GetWithContext(ctx context.Context) (*Account, error) {
 
-	req, err := s.client.prepareRequest(http.MethodGet, accountBasePath, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, accountBasePath, nil)
 	if err != nil {
 		return nil, err
 	}
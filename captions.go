@@ -1,7 +1,9 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -10,10 +12,17 @@ import (
 // See: https://docs.api.video/5.1/captions
 type CaptionsServiceI interface {
 	Get(videoID string, language string) (*Caption, error)
+	GetWithContext(ctx context.Context, videoID string, language string) (*Caption, error)
 	List(videoID string) (*CaptionList, error)
+	ListWithContext(ctx context.Context, videoID string) (*CaptionList, error)
 	Upload(videoID string, language string, filepath string) (*Caption, error)
+	UploadWithContext(ctx context.Context, videoID string, language string, filepath string) (*Caption, error)
+	UploadFromReader(videoID string, language string, name string, r io.Reader) (*Caption, error)
+	UploadFromReaderWithContext(ctx context.Context, videoID string, language string, name string, r io.Reader) (*Caption, error)
 	Update(videoID string, language string, updateRequest *CaptionRequest) (*Caption, error)
+	UpdateWithContext(ctx context.Context, videoID string, language string, updateRequest *CaptionRequest) (*Caption, error)
 	Delete(videoID string, language string) error
+	DeleteWithContext(ctx context.Context, videoID string, language string) error
 }
 
 // CaptionsService communicating with the Captions
@@ -38,11 +47,16 @@ type CaptionList struct {
 
 // CaptionRequest represents a request to update a Caption
 type CaptionRequest struct {
-	Default bool `json:"default"`
+	Default *bool `json:"default,omitempty"`
 }
 
 //Get returns a Caption by video id and language
 func (s *CaptionsService) Get(videoID string, language string) (*Caption, error) {
+	return s.GetWithContext(context.Background(), videoID, language)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *CaptionsService) GetWithContext(ctx context.Context, videoID string, language string) (*Caption, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -51,7 +65,7 @@ func (s *CaptionsService) Get(videoID string, language string) (*Caption, error)
 
 	path := fmt.Sprintf("%s/%s/captions/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +82,11 @@ func (s *CaptionsService) Get(videoID string, language string) (*Caption, error)
 
 //List returns a slice of Caption containing all captions for a videoId
 func (s *CaptionsService) List(videoID string) (*CaptionList, error) {
+	return s.ListWithContext(context.Background(), videoID)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *CaptionsService) ListWithContext(ctx context.Context, videoID string) (*CaptionList, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -76,7 +95,7 @@ func (s *CaptionsService) List(videoID string) (*CaptionList, error) {
 
 	path := fmt.Sprintf("%s/%s/captions", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +112,28 @@ func (s *CaptionsService) List(videoID string) (*CaptionList, error) {
 
 //Upload a vtt for a video and language
 func (s *CaptionsService) Upload(videoID string, language string, filePath string) (*Caption, error) {
+	return s.UploadWithContext(context.Background(), videoID, language, filePath)
+}
+
+//UploadWithContext is the same as Upload with a context controlling the lifetime of the request
+func (s *CaptionsService) UploadWithContext(ctx context.Context, videoID string, language string, filePath string) (*Caption, error) {
+
+	file, name, _, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadFromReaderWithContext(ctx, videoID, language, name, file)
+}
+
+//UploadFromReader upload a vtt for a video and language from r, name is the file name sent to the API
+func (s *CaptionsService) UploadFromReader(videoID string, language string, name string, r io.Reader) (*Caption, error) {
+	return s.UploadFromReaderWithContext(context.Background(), videoID, language, name, r)
+}
+
+//UploadFromReaderWithContext is the same as UploadFromReader with a context controlling the lifetime of the request
+func (s *CaptionsService) UploadFromReaderWithContext(ctx context.Context, videoID string, language string, name string, r io.Reader) (*Caption, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -101,7 +142,7 @@ func (s *CaptionsService) Upload(videoID string, language string, filePath strin
 
 	path := fmt.Sprintf("%s/%s/captions/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareUploadRequest(path, filePath, nil)
+	req, err := s.client.prepareUploadRequest(ctx, path, name, r, nil)
 
 	if err != nil {
 		return nil, err
@@ -120,6 +161,11 @@ func (s *CaptionsService) Upload(videoID string, language string, filePath strin
 
 //Update a video container and returns it
 func (s *CaptionsService) Update(videoID string, language string, updateRequest *CaptionRequest) (*Caption, error) {
+	return s.UpdateWithContext(context.Background(), videoID, language, updateRequest)
+}
+
+//UpdateWithContext is the same as Update with a context controlling the lifetime of the request
+func (s *CaptionsService) UpdateWithContext(ctx context.Context, videoID string, language string, updateRequest *CaptionRequest) (*Caption, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -128,7 +174,7 @@ func (s *CaptionsService) Update(videoID string, language string, updateRequest
 
 	path := fmt.Sprintf("%s/%s/captions/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareRequest(http.MethodPatch, path, updateRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, path, updateRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +191,11 @@ func (s *CaptionsService) Update(videoID string, language string, updateRequest
 
 //Delete a caption
 func (s *CaptionsService) Delete(videoID string, language string) error {
+	return s.DeleteWithContext(context.Background(), videoID, language)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *CaptionsService) DeleteWithContext(ctx context.Context, videoID string, language string) error {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -153,7 +204,7 @@ func (s *CaptionsService) Delete(videoID string, language string) error {
 
 	path := fmt.Sprintf("%s/%s/captions/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
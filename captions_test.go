@@ -3,9 +3,11 @@ package apivideosdk
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -44,7 +46,7 @@ var captionRequestJSON = `{
   }`
 
 var captionRequestStruct = CaptionRequest{
-	Default: true,
+	Default: Bool(true),
 }
 
 func TestCaptions_Get(t *testing.T) {
@@ -116,6 +118,34 @@ func TestCaptions_Upload(t *testing.T) {
 	}
 }
 
+func TestCaptions_UploadFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+	content := "WEBVTT\n\n00:00.000 --> 00:01.000\nHello"
+	mux.HandleFunc("/videos/vi2ZEQZrOQckdYZ3X5sjPse8/captions/en", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Captions.UploadFromReader file part error: %v", err)
+		}
+		data, _ := ioutil.ReadAll(file)
+		if string(data) != content || header.Filename != "captions.vtt" {
+			t.Errorf("Captions.UploadFromReader got file %q=%q want %q=%q", header.Filename, data, "captions.vtt", content)
+		}
+		fmt.Fprint(w, captionJSONResponses[0])
+	})
+
+	caption, err := client.Captions.UploadFromReader("vi2ZEQZrOQckdYZ3X5sjPse8", "en", "captions.vtt", strings.NewReader(content))
+	if err != nil {
+		t.Errorf("Captions.UploadFromReader error: %v", err)
+	}
+
+	expected := &captionStructs[0]
+	if !reflect.DeepEqual(caption, expected) {
+		t.Errorf("Captions.UploadFromReader\n got=%#v\nwant=%#v", caption, expected)
+	}
+}
+
 func TestCaptions_Update(t *testing.T) {
 	setup()
 	defer teardown()
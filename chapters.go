@@ -1,7 +1,9 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -10,9 +12,15 @@ import (
 // See: https://docs.api.video/5.1/chapters
 type ChaptersServiceI interface {
 	Get(videoID string, language string) (*Chapter, error)
+	GetWithContext(ctx context.Context, videoID string, language string) (*Chapter, error)
 	List(videoID string) (*ChapterList, error)
+	ListWithContext(ctx context.Context, videoID string) (*ChapterList, error)
 	Upload(videoID string, language string, filepath string) (*Chapter, error)
+	UploadWithContext(ctx context.Context, videoID string, language string, filepath string) (*Chapter, error)
+	UploadFromReader(videoID string, language string, name string, r io.Reader) (*Chapter, error)
+	UploadFromReaderWithContext(ctx context.Context, videoID string, language string, name string, r io.Reader) (*Chapter, error)
 	Delete(videoID string, language string) error
+	DeleteWithContext(ctx context.Context, videoID string, language string) error
 }
 
 // ChaptersService communicating with the Chapters
@@ -36,6 +44,11 @@ type ChapterList struct {
 
 //Get returns a Chapter by video id and language
 func (s *ChaptersService) Get(videoID string, language string) (*Chapter, error) {
+	return s.GetWithContext(context.Background(), videoID, language)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *ChaptersService) GetWithContext(ctx context.Context, videoID string, language string) (*Chapter, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -44,7 +57,7 @@ func (s *ChaptersService) Get(videoID string, language string) (*Chapter, error)
 
 	path := fmt.Sprintf("%s/%s/chapters/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +74,11 @@ func (s *ChaptersService) Get(videoID string, language string) (*Chapter, error)
 
 //List returns a slice of Chapter containing all chapters for a videoId
 func (s *ChaptersService) List(videoID string) (*ChapterList, error) {
+	return s.ListWithContext(context.Background(), videoID)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *ChaptersService) ListWithContext(ctx context.Context, videoID string) (*ChapterList, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -69,7 +87,7 @@ func (s *ChaptersService) List(videoID string) (*ChapterList, error) {
 
 	path := fmt.Sprintf("%s/%s/chapters", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +104,28 @@ func (s *ChaptersService) List(videoID string) (*ChapterList, error) {
 
 //Upload a vtt for a video and language
 func (s *ChaptersService) Upload(videoID string, language string, filePath string) (*Chapter, error) {
+	return s.UploadWithContext(context.Background(), videoID, language, filePath)
+}
+
+//UploadWithContext is the same as Upload with a context controlling the lifetime of the request
+func (s *ChaptersService) UploadWithContext(ctx context.Context, videoID string, language string, filePath string) (*Chapter, error) {
+
+	file, name, _, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadFromReaderWithContext(ctx, videoID, language, name, file)
+}
+
+//UploadFromReader upload a vtt for a video and language from r, name is the file name sent to the API
+func (s *ChaptersService) UploadFromReader(videoID string, language string, name string, r io.Reader) (*Chapter, error) {
+	return s.UploadFromReaderWithContext(context.Background(), videoID, language, name, r)
+}
+
+//UploadFromReaderWithContext is the same as UploadFromReader with a context controlling the lifetime of the request
+func (s *ChaptersService) UploadFromReaderWithContext(ctx context.Context, videoID string, language string, name string, r io.Reader) (*Chapter, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -94,7 +134,7 @@ func (s *ChaptersService) Upload(videoID string, language string, filePath strin
 
 	path := fmt.Sprintf("%s/%s/chapters/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareUploadRequest(path, filePath, nil)
+	req, err := s.client.prepareUploadRequest(ctx, path, name, r, nil)
 
 	if err != nil {
 		return nil, err
@@ -113,6 +153,11 @@ func (s *ChaptersService) Upload(videoID string, language string, filePath strin
 
 //Delete a chapter
 func (s *ChaptersService) Delete(videoID string, language string) error {
+	return s.DeleteWithContext(context.Background(), videoID, language)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *ChaptersService) DeleteWithContext(ctx context.Context, videoID string, language string) error {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -121,7 +166,7 @@ func (s *ChaptersService) Delete(videoID string, language string) error {
 
 	path := fmt.Sprintf("%s/%s/chapters/%s", videosBasePath, videoID, language)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
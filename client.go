@@ -2,24 +2,45 @@ package apivideosdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
-	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 )
 
 // Client type handles communicating with the api.video API
 type Client struct {
-	BaseURL    *url.URL
-	APIKey     string
-	httpClient *http.Client
-	chunkSize  int64
-	Token      *Token
+	BaseURL     *url.URL
+	APIKey      string
+	httpClient  *http.Client
+	timeout     time.Duration
+	chunkSize   int64
+	userAgent   string
+	retryPolicy RetryPolicy
+	tokenMu     sync.Mutex
+	Token       *Token
+
+	tokenSource    TokenSource
+	tokenCachePath string
+
+	middlewares []Middleware
+	// doer is httpClient wrapped in the middlewares
+	doer        Doer
+	logger      Logger
+	logBodies   int
+	tracer      Tracer
+	meter       Meter
+	rateLimiter RateLimiter
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimit
 
 	Videos       VideosServiceI
 	Livestreams  LivestreamsServiceI
@@ -40,42 +61,67 @@ type Token struct {
 	ExpireTime   time.Time
 }
 
-// ErrorResponse contains an error from the api.video API
-type ErrorResponse struct {
-	Response *http.Response
-	Type     string `json:"type"`
-	Title    string `json:"title"`
-	Name     string `json:"name"`
-}
-
-func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf(
-		"[%d]: %v %v\nType: %v\nTitle: %v\nName: %v",
-		r.Response.StatusCode,
-		r.Response.Request.Method,
-		r.Response.Request.URL,
-		r.Type,
-		r.Title,
-		r.Name,
-	)
-}
-
 const (
 	defaultBaseURL        = "https://ws.api.video/"
 	defaultSandboxBaseURL = "https://sandbox.api.video/"
+	defaultUserAgent      = "apivideo-go-sdk"
+	defaultChunkSize      = 128 * 1024 * 1024
+	tokenRefreshMargin    = 30 * time.Second
 )
 
+// ClientOption configures a Client at construction time
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the http.Client used to send requests,
+// by default http.DefaultClient is used
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithBaseURL overrides the api.video environment URL
+func WithBaseURL(baseURL *url.URL) ClientOption {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithChunkSize changes chunk size for video upload, by default its 128MB
+func WithChunkSize(size int64) ClientOption {
+	return func(c *Client) {
+		c.chunkSize = size
+	}
+}
+
+// WithTimeout sets the timeout of the http.Client used to send requests,
+// whatever the order of the options.
+// The http.Client is copied so http.DefaultClient or a client given
+// with WithHTTPClient is never modified
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
 // NewClient returns a new api.video API client for production
-func NewClient(apiKey string) *Client {
-	return newClient(apiKey, defaultBaseURL)
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	return newClient(apiKey, defaultBaseURL, opts...)
 }
 
 // NewSandboxClient returns a new api.video API client for sandbox environment
-func NewSandboxClient(apiKey string) *Client {
-	return newClient(apiKey, defaultSandboxBaseURL)
+func NewSandboxClient(apiKey string, opts ...ClientOption) *Client {
+	return newClient(apiKey, defaultSandboxBaseURL, opts...)
 }
 
-func newClient(apiKey, envURL string) *Client {
+func newClient(apiKey, envURL string, opts ...ClientOption) *Client {
 
 	baseURL, _ := url.Parse(envURL)
 
@@ -83,7 +129,25 @@ func newClient(apiKey, envURL string) *Client {
 		BaseURL:    baseURL,
 		APIKey:     apiKey,
 		httpClient: http.DefaultClient,
-		chunkSize:  128 * 1024 * 1024,
+		chunkSize:  defaultChunkSize,
+		userAgent:  defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.timeout > 0 {
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
+	c.doer = chain(c.httpClient, c.middlewares)
+	if c.tokenSource == nil {
+		c.tokenSource = c.APIKeyTokenSource()
+	}
+	if c.tokenCachePath != "" {
+		c.tokenSource = FileCacheTokenSource(c.tokenCachePath, c.tokenSource)
 	}
 
 	c.Videos = &VideosService{client: c}
@@ -103,159 +167,294 @@ func (c *Client) ChunkSize(size int64) {
 	c.chunkSize = size
 }
 
-func (c *Client) prepareRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+func (c *Client) prepareRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+
+	req, err := c.newRequest(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.auth(req)
+}
+
+// newRequest returns a request without authentication
+func (c *Client) newRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	buf := new(bytes.Buffer)
+	var reqBody io.Reader = new(bytes.Buffer)
 	if body != nil {
 
 		switch v := body.(type) {
-		case *bytes.Buffer:
-			buf = v
+		case io.Reader:
+			reqBody = v
 		default:
+			buf := new(bytes.Buffer)
 			err = json.NewEncoder(buf).Encode(body)
 			if err != nil {
 				return nil, err
 			}
+			reqBody = buf
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return req, nil
+}
+
+// prepareUploadRequest returns a multipart request streaming r as the file part,
+// the content is never fully loaded in memory
+func (c *Client) prepareUploadRequest(ctx context.Context, urlStr string, name string, r io.Reader, extraFields map[string]string) (*http.Request, error) {
 
-	req, err = c.auth(req)
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
 
+	req, err := c.prepareRequest(ctx, http.MethodPost, urlStr, pr)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	go func() {
+		part, err := writer.CreateFormFile("file", name)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+
+		for key, val := range extraFields {
+			if err != nil {
+				break
+			}
+			err = writer.WriteField(key, val)
+		}
+
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
 	return req, nil
 }
 
-func (c *Client) prepareRangeRequests(urlStr string, filePath string) ([]*http.Request, error) {
+// openFile opens the file at filePath for upload, returning its name and size
+func openFile(filePath string) (*os.File, string, int64, error) {
 
 	file, err := os.Open(filePath)
 	if err != nil {
-		return nil, err
+		return nil, "", 0, err
 	}
 
-	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, "", 0, err
+	}
 
-	stat, _ := file.Stat()
+	return file, filepath.Base(filePath), stat.Size(), nil
+}
 
-	var bufSize int64
-	if stat.Size() > c.chunkSize && c.chunkSize != 0 {
-		bufSize = c.chunkSize
-	} else {
-		bufSize = stat.Size()
+func (c *Client) do(req *http.Request, v interface{}) (resp *http.Response, err error) {
+	if c.tracer != nil || c.meter != nil {
+		var end func(*http.Response, error)
+		req, end = c.startCall(req)
+		defer func() { end(resp, err) }()
 	}
 
-	buf := make([]byte, bufSize)
-	requests := []*http.Request{}
-	startByte := 0
-	for {
-		bytesread, err := file.Read(buf)
-		if err != nil {
-			if err != io.EOF {
-				return nil, err
-			}
-			break
-		}
+	resp, err = c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-		body := new(bytes.Buffer)
-		writer := multipart.NewWriter(body)
-		part, err := writer.CreateFormFile("file", file.Name())
+	if v != nil {
+		err = json.NewDecoder(resp.Body).Decode(v)
 		if err != nil {
 			return nil, err
 		}
-		part.Write(buf)
+	}
 
-		err = writer.Close()
-		if err != nil {
-			return nil, err
-		}
+	return resp, nil
+}
 
-		req, err := c.prepareRequest(http.MethodPost, urlStr, body)
-		if err != nil {
-			return nil, err
+// roundTrip sends the given attempt of req through the middlewares, once the
+// rate limiter allows it, and records the rate limit of the response
+func (c *Client) roundTrip(req *http.Request, attempt int) (*http.Response, error) {
+	err := c.throttle(req)
+	if err != nil {
+		// The body is never sent, close it as http.Client.Do does on failures
+		// so the writer of a piped body is released
+		if req.Body != nil {
+			req.Body.Close()
 		}
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := c.doer.Do(req)
+	if c.logger != nil {
+		c.logRoundTrip(req, attempt, resp, err, time.Since(start))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.recordRateLimit(resp)
+
+	return resp, nil
+}
+
+// send sends req, retrying transient failures according to the client RetryPolicy.
+// On success the caller is responsible for closing the response body
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	maxAttempts := c.retryPolicy.maxAttempts(req)
+	reauthenticated := false
 
-		req.Header.Set("Content-Type", writer.FormDataContentType())
+	for attempt := 1; ; attempt++ {
+		resp, err := c.roundTrip(req, attempt)
+		if err != nil {
+			if attempt >= maxAttempts || req.Context().Err() != nil {
+				return nil, err
+			}
+		} else {
+			err = checkResponse(resp)
+			if err == nil {
+				return resp, nil
+			}
+			resp.Body.Close()
+
+			// The token may have been revoked or expired early, authenticate
+			// again and send the request once more
+			if resp.StatusCode == http.StatusUnauthorized && !reauthenticated && canRewindBody(req) {
+				authorization := req.Header.Get("Authorization")
+				if strings.HasPrefix(authorization, "Bearer ") {
+					reauthenticated = true
+					if i, ok := c.tokenSource.(tokenInvalidator); ok {
+						i.invalidate(strings.TrimPrefix(authorization, "Bearer "))
+					}
+
+					req, err = rewindBody(req)
+					if err != nil {
+						return nil, err
+					}
+					req, err = c.auth(req)
+					if err != nil {
+						return nil, err
+					}
+					attempt--
+					continue
+				}
+			}
 
-		if stat.Size() > c.chunkSize && c.chunkSize != 0 {
-			ranges := fmt.Sprintf("bytes %d-%d/%d", startByte, (startByte+bytesread)-1, stat.Size())
-			req.Header.Set("Content-Range", ranges)
-			startByte = startByte + bytesread
+			if errorResponse, ok := err.(*ErrorResponse); ok {
+				errorResponse.Attempts = attempt
+			}
+			if attempt >= maxAttempts || !isRetryableStatus(resp.StatusCode) {
+				return nil, err
+			}
 		}
 
+		err = sleepContext(req.Context(), c.retryPolicy.backoff(attempt+1, resp))
 		if err != nil {
 			return nil, err
 		}
 
-		requests = append(requests, req)
+		req, err = rewindBody(req)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return requests, nil
 }
 
-func (c *Client) prepareUploadRequest(urlStr string, filePath string, extraFields map[string]string) (*http.Request, error) {
+func (c *Client) auth(req *http.Request) (*http.Request, error) {
 
-	file, err := os.Open(filePath)
+	token, err := sourceToken(req.Context(), c.tokenSource)
 	if err != nil {
 		return nil, err
 	}
+	if token == nil {
+		return nil, errors.New("token source returned no token")
+	}
 
-	defer file.Close()
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return req, nil
+}
 
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-	part, err := writer.CreateFormFile("file", file.Name())
-	if err != nil {
-		return nil, err
+// token returns a valid access token, refreshing it shortly before it expires.
+// Concurrent callers wait for a single refresh instead of each starting their own
+func (c *Client) token(ctx context.Context) (*Token, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.Token != nil && time.Now().Add(tokenRefreshMargin).Before(c.Token.ExpireTime) {
+		return c.Token, nil
 	}
-	_, err = io.Copy(part, file)
+
+	var token *Token
+	var err error
+	if c.Token != nil && c.Token.RefreshToken != "" {
+		payload := map[string]string{"refreshToken": c.Token.RefreshToken}
+		token, err = c.requestToken(ctx, "/auth/refresh", payload)
+	}
+
+	// Fall back to the API key when there is no refresh token or the refresh failed
+	if token == nil {
+		payload := map[string]string{"apiKey": c.APIKey}
+		token, err = c.requestToken(ctx, "/auth/api-key", payload)
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	if extraFields != nil {
-		for key, val := range extraFields {
-			err = writer.WriteField(key, val)
-			if err != nil {
-				return nil, err
-			}
-		}
+	c.Token = token
+	return token, nil
+}
+
+// invalidateToken drops the current token if it is still the given one,
+// forcing the next request to authenticate again
+func (c *Client) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.Token != nil && c.Token.AccessToken == accessToken {
+		c.Token = nil
 	}
+}
+
+func (c *Client) requestToken(ctx context.Context, urlStr string, payload interface{}) (*Token, error) {
 
-	err = writer.Close()
+	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := c.prepareRequest(http.MethodPost, urlStr, body)
+	buf := new(bytes.Buffer)
+	err = json.NewEncoder(buf).Encode(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
 
-	return req, nil
-}
-
-func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.roundTrip(req, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -266,65 +465,13 @@ func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
 		return nil, err
 	}
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return resp, nil
-}
-
-func (c *Client) auth(req *http.Request) (*http.Request, error) {
-
-	if c.Token == nil || time.Now().After(c.Token.ExpireTime) {
-		u, err := c.BaseURL.Parse("/auth/api-key")
-		if err != nil {
-			return nil, err
-		}
-
-		payload := map[string]string{"apiKey": c.APIKey}
-
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(payload)
-		resp, err := c.httpClient.Post(u.String(), "application/json", buf)
-
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
-
-		err = checkResponse(resp)
-		if err != nil {
-			return nil, err
-		}
-
-		err = json.NewDecoder(resp.Body).Decode(&c.Token)
-		if err != nil {
-			return nil, err
-		}
-
-		c.Token.ExpireTime = time.Now().Add(time.Duration(c.Token.ExpiresIn) * time.Second)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.Token.AccessToken)
-	return req, nil
-}
-
-func checkResponse(r *http.Response) error {
-	if c := r.StatusCode; c >= 200 && c <= 299 {
-		return nil
+	token := new(Token)
+	err = json.NewDecoder(resp.Body).Decode(token)
+	if err != nil {
+		return nil, err
 	}
-	errorResponse := &ErrorResponse{Response: r}
-	data, err := ioutil.ReadAll(r.Body)
 
-	if err == nil && len(data) > 0 {
-		err := json.Unmarshal(data, errorResponse)
-		if err != nil {
-			errorResponse.Title = string(data)
-		}
-	}
+	token.ExpireTime = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
 
-	return errorResponse
+	return token, nil
 }
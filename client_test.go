@@ -1,13 +1,18 @@
 package apivideosdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 var (
@@ -101,3 +106,188 @@ func createTempFile(filename string, size int64) string {
 	}
 	return filename
 }
+
+func TestNewClient_Options(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var userAgents []string
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		fmt.Fprint(w, `{}`)
+	})
+
+	baseURL, _ := url.Parse(server.URL)
+	httpClient := &http.Client{}
+
+	c := NewClient("apiKey",
+		WithBaseURL(baseURL),
+		WithHTTPClient(httpClient),
+		WithUserAgent("my-app/1.0"),
+		WithChunkSize(1024),
+		WithTimeout(5*time.Second),
+	)
+
+	if c.BaseURL != baseURL {
+		t.Errorf("NewClient BaseURL got=%v want=%v", c.BaseURL, baseURL)
+	}
+	if c.chunkSize != 1024 {
+		t.Errorf("NewClient chunkSize got=%d want=%d", c.chunkSize, 1024)
+	}
+	if c.httpClient.Timeout != 5*time.Second {
+		t.Errorf("NewClient timeout got=%v want=%v", c.httpClient.Timeout, 5*time.Second)
+	}
+	if httpClient.Timeout != 0 || http.DefaultClient.Timeout != 0 {
+		t.Errorf("NewClient WithTimeout should not modify the given http.Client")
+	}
+
+	reordered := NewClient("apiKey", WithTimeout(5*time.Second), WithHTTPClient(httpClient))
+	if reordered.httpClient.Timeout != 5*time.Second {
+		t.Errorf("NewClient WithTimeout before WithHTTPClient timeout got=%v want=%v", reordered.httpClient.Timeout, 5*time.Second)
+	}
+	if httpClient.Timeout != 0 {
+		t.Errorf("NewClient WithTimeout should not modify the given http.Client")
+	}
+
+	_, err := c.Account.Get()
+	if err != nil {
+		t.Errorf("Account.Get error: %v", err)
+	}
+
+	expected := []string{"my-app/1.0"}
+	if !reflect.DeepEqual(userAgents, expected) {
+		t.Errorf("NewClient User-Agent\n got=%#v\nwant=%#v", userAgents, expected)
+	}
+}
+
+func TestClient_ConcurrentAuth(t *testing.T) {
+	mux = http.NewServeMux()
+	server = httptest.NewServer(mux)
+	defer teardown()
+
+	var authCalls int32
+	mux.HandleFunc("/auth/api-key", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&authCalls, 1)
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"access_token": "fakeToken", "refresh_token": "fakeRefresh", "expires_in": 3600}`)
+	})
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Account.Get(); err != nil {
+				t.Errorf("Account.Get error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if authCalls != 1 {
+		t.Errorf("Client should authenticate once for concurrent requests, got %d", authCalls)
+	}
+}
+
+func TestClient_RefreshToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/auth/refresh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["refreshToken"] != "oldRefresh" {
+			t.Errorf("Client refresh token got=%s want=%s", body["refreshToken"], "oldRefresh")
+		}
+		fmt.Fprint(w, `{"access_token": "refreshedToken", "refresh_token": "newRefresh", "expires_in": 3600}`)
+	})
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer refreshedToken" {
+			t.Errorf("Client Authorization got=%s want=%s", r.Header.Get("Authorization"), "Bearer refreshedToken")
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	// The token expires within the refresh margin, it is refreshed proactively
+	client.Token = &Token{
+		AccessToken:  "oldToken",
+		RefreshToken: "oldRefresh",
+		ExpireTime:   time.Now().Add(5 * time.Second),
+	}
+
+	_, err := client.Account.Get()
+	if err != nil {
+		t.Errorf("Account.Get error: %v", err)
+	}
+
+	if client.Token.RefreshToken != "newRefresh" {
+		t.Errorf("Client refresh token should be updated, got %s", client.Token.RefreshToken)
+	}
+}
+
+func TestClient_RefreshTokenFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/auth/refresh", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fakeToken" {
+			t.Errorf("Client Authorization got=%s want=%s", r.Header.Get("Authorization"), "Bearer fakeToken")
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	client.Token = &Token{
+		AccessToken:  "oldToken",
+		RefreshToken: "oldRefresh",
+		ExpireTime:   time.Now().Add(-time.Second),
+	}
+
+	_, err := client.Account.Get()
+	if err != nil {
+		t.Errorf("Account.Get error: %v", err)
+	}
+}
+
+func TestClient_RetryUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var count int
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.Header.Get("Authorization") == "Bearer revokedToken" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body := new(VideoRequest)
+		json.NewDecoder(r.Body).Decode(body)
+		if body.Title != "title" {
+			t.Errorf("Videos.Create body should be sent again, got title=%q", body.Title)
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	client.Token = &Token{
+		AccessToken: "revokedToken",
+		ExpireTime:  time.Now().Add(time.Hour),
+	}
+
+	_, err := client.Videos.Create(&VideoRequest{Title: "title"})
+	if err != nil {
+		t.Errorf("Videos.Create error: %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("Videos.Create should be sent again once after a 401, got %d calls", count)
+	}
+}
@@ -1,7 +1,6 @@
 package apivideosdk
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 )
@@ -22,9 +21,21 @@ type Link struct {
 	URI string `json:"uri,omitempty"`
 }
 
+//Bool returns a pointer to v, to set the optional booleans of request types
+func Bool(v bool) *bool {
+	return &v
+}
+
 func checkVideoID(videoID string) error {
 	if !strings.HasPrefix(videoID, "vi") {
-		return fmt.Errorf("Video id %s is invalid, it must start with 'vi'", videoID)
+		return invalidIDf("Video id %s is invalid, it must start with 'vi'", videoID)
+	}
+	return nil
+}
+
+func checkUploadSize(size int64) error {
+	if size < 0 {
+		return invalidf("Upload size %d is invalid, it must be the number of bytes to read", size)
 	}
 	return nil
 }
@@ -34,44 +45,71 @@ func checkTimecode(timecode string) error {
 	var rxPat = regexp.MustCompile(`^[0-9]{2}(:[0-9]{2}){3}$`)
 
 	if !rxPat.MatchString(timecode) {
-		return fmt.Errorf("Timecode format is invalid, it must of type '00:00:00:00'")
+		return invalidf("Timecode format is invalid, it must of type '00:00:00:00'")
 	}
 	return nil
 }
 
 func checkOpts(opts *VideoOpts) error {
 
-	var rxPat = regexp.MustCompile(`^(publishedAt|updatedAt|title)$`)
+	switch opts.SortBy {
+	case "", SortByPublishedAt, SortByUpdatedAt, SortByTitle:
+	default:
+		return invalidf("SortBy value is invalid, it must be 'publishedAt', 'updatedAt' or 'title'")
+	}
+
+	return checkSortOrder(opts.SortOrder)
+}
+
+func checkUploadTokenOpts(opts *UploadTokenOpts) error {
 
-	if opts.SortBy != "" && !rxPat.MatchString(opts.SortBy) {
-		return fmt.Errorf("SortBy value is invalid, it must be 'publishedAt', 'updatedAt' or 'title'")
+	switch opts.SortBy {
+	case "", SortByCreatedAt, SortByTTL:
+	default:
+		return invalidf("SortBy value is invalid, it must be 'createdAt' or 'ttl'")
 	}
 
-	rxPat = regexp.MustCompile(`^(asc|desc)$`)
-	if opts.SortOrder != "" && !rxPat.MatchString(opts.SortOrder) {
-		return fmt.Errorf("SortOrder value is invalid, it must be 'asc' or 'desc'")
+	return checkSortOrder(opts.SortOrder)
+}
+
+func checkSortOrder(order SortOrder) error {
+	if order != "" && !order.Valid() {
+		return invalidf("SortOrder value is invalid, it must be 'asc' or 'desc'")
 	}
+	return nil
+}
 
+func checkPeriod(period Period) error {
+	if period != "" && !period.Valid() {
+		return invalidf("Period value %s is invalid", period)
+	}
 	return nil
 }
 
 func checkPlayerID(PlayerID string) error {
 	if !strings.HasPrefix(PlayerID, "pl") && !strings.HasPrefix(PlayerID, "pt") {
-		return fmt.Errorf("Player id %s is invalid, it must start with 'pl' or 'pt'", PlayerID)
+		return invalidIDf("Player id %s is invalid, it must start with 'pl' or 'pt'", PlayerID)
 	}
 	return nil
 }
 
 func checkLivestreamID(livestreamID string) error {
 	if !strings.HasPrefix(livestreamID, "li") {
-		return fmt.Errorf("Livestream id %s is invalid, it must start with 'li'", livestreamID)
+		return invalidIDf("Livestream id %s is invalid, it must start with 'li'", livestreamID)
 	}
 	return nil
 }
 
 func checkSessionID(sessionID string) error {
 	if !strings.HasPrefix(sessionID, "ps") {
-		return fmt.Errorf("Session id %s is invalid, it must start with 'ps'", sessionID)
+		return invalidIDf("Session id %s is invalid, it must start with 'ps'", sessionID)
+	}
+	return nil
+}
+
+func checkUploadToken(token string) error {
+	if !strings.HasPrefix(token, "to") {
+		return invalidIDf("Upload token %s is invalid, it must start with 'to'", token)
 	}
 	return nil
 }
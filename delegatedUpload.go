@@ -0,0 +1,133 @@
+package apivideosdk
+
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+const delegatedUploadPath = "upload"
+
+// DelegatedUploader uploads videos with an upload token instead of an API key,
+// so the upload can be handed to an untrusted party.
+// Each upload creates a new video.
+// See: https://docs.api.video/5.1/videos-delegated-upload
+type DelegatedUploader struct {
+	client *Client
+	token  string
+}
+
+// NewDelegatedUploader returns a new DelegatedUploader uploading with token
+func NewDelegatedUploader(token string, opts ...ClientOption) *DelegatedUploader {
+	return &DelegatedUploader{client: newClient("", defaultBaseURL, opts...), token: token}
+}
+
+// NewSandboxDelegatedUploader returns a new DelegatedUploader for sandbox environment
+func NewSandboxDelegatedUploader(token string, opts ...ClientOption) *DelegatedUploader {
+	return &DelegatedUploader{client: newClient("", defaultSandboxBaseURL, opts...), token: token}
+}
+
+// Token returns the upload token used by the uploader
+func (u *DelegatedUploader) Token() string {
+	return u.token
+}
+
+func (u *DelegatedUploader) newUpload(name string, r io.Reader, size int64, opts []UploadOption) *chunkedUpload {
+	return &chunkedUpload{
+		urlStr:    delegatedUploadPath + "?token=" + url.QueryEscape(u.token),
+		name:      name,
+		r:         r,
+		size:      size,
+		options:   newUploadOptions(opts),
+		anonymous: true,
+		delegated: true,
+	}
+}
+
+//Upload creates a video from the file at filePath.
+//The upload is chuncked if the file size is more than 128MB
+func (u *DelegatedUploader) Upload(filePath string, opts ...UploadOption) (*Video, error) {
+	return u.UploadWithContext(context.Background(), filePath, opts...)
+}
+
+//UploadWithContext is the same as Upload with a context controlling the lifetime of the request
+func (u *DelegatedUploader) UploadWithContext(ctx context.Context, filePath string, opts ...UploadOption) (*Video, error) {
+
+	file, name, size, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return u.UploadFromReaderWithContext(ctx, name, file, size, opts...)
+}
+
+//UploadFromReader creates a video from r.
+//name is the file name sent to the API and size the number of bytes to read from r.
+//The upload is chuncked if the size is more than 128MB
+func (u *DelegatedUploader) UploadFromReader(name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error) {
+	return u.UploadFromReaderWithContext(context.Background(), name, r, size, opts...)
+}
+
+//UploadFromReaderWithContext is the same as UploadFromReader with a context controlling the lifetime of the request
+func (u *DelegatedUploader) UploadFromReaderWithContext(ctx context.Context, name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error) {
+
+	err := checkUploadSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(Video)
+
+	err = u.client.uploadChunks(ctx, u.newUpload(name, r, size, opts), v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+//UploadResumable creates a video from the file at filePath, saving an UploadSession
+//at sessionPath after every chunk accepted by the API.
+//If sessionPath holds the session of a previous upload of the same file with the same token,
+//the upload continues the video created by that session after its last acknowledged byte.
+//The session is removed once the upload is complete
+func (u *DelegatedUploader) UploadResumable(filePath string, sessionPath string, opts ...UploadOption) (*Video, error) {
+	return u.UploadResumableWithContext(context.Background(), filePath, sessionPath, opts...)
+}
+
+//UploadResumableWithContext is the same as UploadResumable with a context controlling the lifetime of the request
+func (u *DelegatedUploader) UploadResumableWithContext(ctx context.Context, filePath string, sessionPath string, opts ...UploadOption) (*Video, error) {
+
+	file, name, size, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	session, err := newFileUploadSession(file)
+	if err != nil {
+		return nil, err
+	}
+	session.UploadToken = u.token
+
+	// The ingest status requires an API key, only acknowledged chunks are known
+	missing := func(saved *UploadSession) []byteRange {
+		return acknowledgedRanges(size, saved.AcknowledgedBytes)
+	}
+
+	v := new(Video)
+
+	session, complete, err := u.client.uploadResumable(ctx, u.newUpload(name, file, size, opts), session, sessionPath, missing, v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if complete {
+		return &Video{VideoID: session.VideoID}, nil
+	}
+
+	return v, nil
+}
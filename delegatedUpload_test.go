@@ -0,0 +1,130 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestDelegatedUploader(token string) *DelegatedUploader {
+	baseURL, _ := url.Parse(server.URL)
+	u := NewDelegatedUploader(token, WithBaseURL(baseURL))
+	u.client.ChunkSize(1024 * 1024)
+	return u
+}
+
+func TestDelegatedUploader_Upload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var headers []string
+	var videoIDs []string
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if r.URL.Query().Get("token") != "to1tcmSFHeYY5KzyhOqVKMKb" {
+			t.Errorf("DelegatedUploader.Upload token got=%s", r.URL.Query().Get("token"))
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("DelegatedUploader.Upload should not authenticate, got Authorization=%s", r.Header.Get("Authorization"))
+		}
+		headers = append(headers, r.Header.Get("Content-Range"))
+		videoIDs = append(videoIDs, r.FormValue("videoId"))
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	data := make([]byte, 3*1024*1024)
+	video, err := newTestDelegatedUploader("to1tcmSFHeYY5KzyhOqVKMKb").UploadFromReader("test.video", bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("DelegatedUploader.UploadFromReader error: %v", err)
+	}
+
+	expectedHeaders := []string{
+		"bytes 0-1048575/3145728",
+		"bytes 1048576-2097151/3145728",
+		"bytes 2097152-3145727/3145728",
+	}
+	if !reflect.DeepEqual(headers, expectedHeaders) {
+		t.Errorf("DelegatedUploader.UploadFromReader Content-Range\n got=%#v\nwant=%#v", headers, expectedHeaders)
+	}
+
+	expectedVideoIDs := []string{"", "vi4k0jvEUuaTdRAEjQ4Jfagz", "vi4k0jvEUuaTdRAEjQ4Jfagz"}
+	if !reflect.DeepEqual(videoIDs, expectedVideoIDs) {
+		t.Errorf("DelegatedUploader.UploadFromReader videoId\n got=%#v\nwant=%#v", videoIDs, expectedVideoIDs)
+	}
+
+	expected := &videoStructs[0]
+	if !reflect.DeepEqual(video, expected) {
+		t.Errorf("DelegatedUploader.UploadFromReader\n got=%#v\nwant=%#v", video, expected)
+	}
+}
+
+func TestDelegatedUploader_UploadResumable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "apivideo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sessionPath := filepath.Join(dir, "session.json")
+
+	var headers []string
+	var videoIDs []string
+	failed := false
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Content-Range"))
+		videoIDs = append(videoIDs, r.FormValue("videoId"))
+		if len(headers) == 2 && !failed {
+			failed = true
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	file := createTempFile(filepath.Join(dir, "test.video"), 3*1024*1024)
+	uploader := newTestDelegatedUploader("to1tcmSFHeYY5KzyhOqVKMKb")
+
+	_, err = uploader.UploadResumable(file, sessionPath)
+	if err == nil {
+		t.Fatalf("DelegatedUploader.UploadResumable should fail on the second chunk")
+	}
+
+	session, err := LoadUploadSession(sessionPath)
+	if err != nil {
+		t.Fatalf("DelegatedUploader.UploadResumable should save the session: %v", err)
+	}
+	if session.VideoID != "vi4k0jvEUuaTdRAEjQ4Jfagz" || session.UploadToken != "to1tcmSFHeYY5KzyhOqVKMKb" || session.AcknowledgedBytes != 1024*1024 {
+		t.Errorf("DelegatedUploader.UploadResumable session got=%#v", session)
+	}
+
+	headers = nil
+	videoIDs = nil
+	_, err = uploader.UploadResumable(file, sessionPath)
+	if err != nil {
+		t.Errorf("DelegatedUploader.UploadResumable error: %v", err)
+	}
+
+	expectedHeaders := []string{
+		"bytes 1048576-2097151/3145728",
+		"bytes 2097152-3145727/3145728",
+	}
+	if !reflect.DeepEqual(headers, expectedHeaders) {
+		t.Errorf("DelegatedUploader.UploadResumable should only send missing bytes\n got=%#v\nwant=%#v", headers, expectedHeaders)
+	}
+	expectedVideoIDs := []string{"vi4k0jvEUuaTdRAEjQ4Jfagz", "vi4k0jvEUuaTdRAEjQ4Jfagz"}
+	if !reflect.DeepEqual(videoIDs, expectedVideoIDs) {
+		t.Errorf("DelegatedUploader.UploadResumable videoId\n got=%#v\nwant=%#v", videoIDs, expectedVideoIDs)
+	}
+
+	if _, err := os.Stat(sessionPath); !os.IsNotExist(err) {
+		t.Errorf("DelegatedUploader.UploadResumable should remove the session once complete")
+	}
+}
@@ -0,0 +1,225 @@
+package apivideosdk
+
+import (
+	"regexp"
+	"strings"
+)
+
+// IngestStatus is the status of the reception of a video source.
+// IngestStatus, QualityName and QualityStatus are read from the API and
+// keep any value it returns, Valid reports whether the value is a known one
+type IngestStatus string
+
+// Ingest statuses
+const (
+	IngestMissing   IngestStatus = "missing"
+	IngestUploading IngestStatus = "uploading"
+	IngestUploaded  IngestStatus = "uploaded"
+)
+
+// QualityName is the name of an encoded quality of a video
+type QualityName string
+
+// Quality names
+const (
+	Quality240p  QualityName = "240p"
+	Quality360p  QualityName = "360p"
+	Quality480p  QualityName = "480p"
+	Quality720p  QualityName = "720p"
+	Quality1080p QualityName = "1080p"
+	Quality2160p QualityName = "2160p"
+)
+
+// QualityStatus is the encoding status of a quality
+type QualityStatus string
+
+// Quality statuses
+const (
+	QualityWaiting  QualityStatus = "waiting"
+	QualityEncoding QualityStatus = "encoding"
+	QualityEncoded  QualityStatus = "encoded"
+	QualityFailed   QualityStatus = "failed"
+)
+
+// SortField is the field a list is sorted by
+type SortField string
+
+// Sort fields, videos are sorted by SortByPublishedAt, SortByUpdatedAt or SortByTitle
+// and upload tokens by SortByCreatedAt or SortByTTL
+const (
+	SortByPublishedAt SortField = "publishedAt"
+	SortByUpdatedAt   SortField = "updatedAt"
+	SortByTitle       SortField = "title"
+	SortByCreatedAt   SortField = "createdAt"
+	SortByTTL         SortField = "ttl"
+)
+
+// SortOrder is the order of a sorted list
+type SortOrder string
+
+// Sort orders
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Period is the period covered by statistics, either a relative period constant,
+// a day "2019-12-02", a week "2019-W49", a month "2019-12", a year "2019"
+// or a range of two of these separated by a slash
+type Period string
+
+// Relative periods
+const (
+	PeriodToday     Period = "today"
+	PeriodYesterday Period = "yesterday"
+	PeriodThisWeek  Period = "this week"
+	PeriodLastWeek  Period = "last week"
+	PeriodThisMonth Period = "this month"
+	PeriodLastMonth Period = "last month"
+	PeriodThisYear  Period = "this year"
+	PeriodLastYear  Period = "last year"
+)
+
+var (
+	ingestStatuses  = []string{string(IngestMissing), string(IngestUploading), string(IngestUploaded)}
+	qualityNames    = []string{string(Quality240p), string(Quality360p), string(Quality480p), string(Quality720p), string(Quality1080p), string(Quality2160p)}
+	qualityStatuses = []string{string(QualityWaiting), string(QualityEncoding), string(QualityEncoded), string(QualityFailed)}
+	sortFields      = []string{string(SortByPublishedAt), string(SortByUpdatedAt), string(SortByTitle), string(SortByCreatedAt), string(SortByTTL)}
+	sortOrders      = []string{string(SortAsc), string(SortDesc)}
+	relativePeriods = []string{
+		string(PeriodToday), string(PeriodYesterday), string(PeriodThisWeek), string(PeriodLastWeek),
+		string(PeriodThisMonth), string(PeriodLastMonth), string(PeriodThisYear), string(PeriodLastYear),
+	}
+
+	absolutePeriod = regexp.MustCompile(`^[0-9]{4}(-W[0-9]{2}|-[0-9]{2}(-[0-9]{2})?)?$`)
+)
+
+func oneOf(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func invalidEnum(kind string, value string, values []string) error {
+	return invalidf("%s value %q is invalid, it must be one of '%s'", kind, value, strings.Join(values, "', '"))
+}
+
+// Valid reports whether s is a known ingest status
+func (s IngestStatus) Valid() bool {
+	return oneOf(string(s), ingestStatuses)
+}
+
+func (s IngestStatus) String() string {
+	return string(s)
+}
+
+// Valid reports whether q is a known quality name
+func (q QualityName) Valid() bool {
+	return oneOf(string(q), qualityNames)
+}
+
+func (q QualityName) String() string {
+	return string(q)
+}
+
+// Valid reports whether s is a known quality status
+func (s QualityStatus) Valid() bool {
+	return oneOf(string(s), qualityStatuses)
+}
+
+func (s QualityStatus) String() string {
+	return string(s)
+}
+
+// Valid reports whether f is a known sort field
+func (f SortField) Valid() bool {
+	return oneOf(string(f), sortFields)
+}
+
+func (f SortField) String() string {
+	return string(f)
+}
+
+// MarshalText fails on unknown sort fields, the empty value means unset
+func (f SortField) MarshalText() ([]byte, error) {
+	if f != "" && !f.Valid() {
+		return nil, invalidEnum("SortField", string(f), sortFields)
+	}
+	return []byte(f), nil
+}
+
+// UnmarshalText fails on unknown sort fields, the empty value means unset
+func (f *SortField) UnmarshalText(text []byte) error {
+	v := SortField(text)
+	if v != "" && !v.Valid() {
+		return invalidEnum("SortField", string(v), sortFields)
+	}
+	*f = v
+	return nil
+}
+
+// Valid reports whether o is a known sort order
+func (o SortOrder) Valid() bool {
+	return oneOf(string(o), sortOrders)
+}
+
+func (o SortOrder) String() string {
+	return string(o)
+}
+
+// MarshalText fails on unknown sort orders, the empty value means unset
+func (o SortOrder) MarshalText() ([]byte, error) {
+	if o != "" && !o.Valid() {
+		return nil, invalidEnum("SortOrder", string(o), sortOrders)
+	}
+	return []byte(o), nil
+}
+
+// UnmarshalText fails on unknown sort orders, the empty value means unset
+func (o *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	if v != "" && !v.Valid() {
+		return invalidEnum("SortOrder", string(v), sortOrders)
+	}
+	*o = v
+	return nil
+}
+
+// Valid reports whether p is a relative period, an absolute period or a range of two of them
+func (p Period) Valid() bool {
+	parts := strings.Split(string(p), "/")
+	if len(parts) > 2 {
+		return false
+	}
+	for _, part := range parts {
+		if !oneOf(part, relativePeriods) && !absolutePeriod.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Period) String() string {
+	return string(p)
+}
+
+// MarshalText fails on invalid periods, the empty value means unset
+func (p Period) MarshalText() ([]byte, error) {
+	if p != "" && !p.Valid() {
+		return nil, invalidf("Period value %q is invalid", string(p))
+	}
+	return []byte(p), nil
+}
+
+// UnmarshalText fails on invalid periods, the empty value means unset
+func (p *Period) UnmarshalText(text []byte) error {
+	v := Period(text)
+	if v != "" && !v.Valid() {
+		return invalidf("Period value %q is invalid", string(v))
+	}
+	*p = v
+	return nil
+}
@@ -0,0 +1,110 @@
+package apivideosdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPeriod_Valid(t *testing.T) {
+	tests := []struct {
+		period Period
+		valid  bool
+	}{
+		{PeriodToday, true},
+		{PeriodLastMonth, true},
+		{"2019-12-02", true},
+		{"2019-W49", true},
+		{"2019-12", true},
+		{"2019", true},
+		{"2019-12-01/2019-12-31", true},
+		{"2019-12/today", true},
+		{"tomorrow", false},
+		{"2019-12-02/2019-12-03/2019-12-04", false},
+		{"12-2019", false},
+	}
+
+	for _, test := range tests {
+		if test.period.Valid() != test.valid {
+			t.Errorf("Period(%q).Valid() got=%v want=%v", test.period, !test.valid, test.valid)
+		}
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		SortBy    SortField
+		SortOrder SortOrder
+		Period    Period
+	}{SortByTitle, SortDesc, PeriodThisWeek})
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	expected := `{"SortBy":"title","SortOrder":"desc","Period":"this week"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal got=%s want=%s", data, expected)
+	}
+
+	_, err = json.Marshal(SortOrder("up"))
+	if err == nil {
+		t.Errorf("json.Marshal should reject an invalid SortOrder")
+	}
+
+	var field SortField
+	err = json.Unmarshal([]byte(`"size"`), &field)
+	if err == nil {
+		t.Errorf("json.Unmarshal should reject an invalid SortField")
+	}
+
+	// Values read from the API are kept even when unknown
+	var quality Quality
+	err = json.Unmarshal([]byte(`{"quality": "4320p", "status": "queued"}`), &quality)
+	if err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if quality.Quality != "4320p" || quality.Quality.Valid() || quality.Status != "queued" || quality.Status.Valid() {
+		t.Errorf("json.Unmarshal got=%#v", quality)
+	}
+}
+
+func TestEnums_JSONZeroValue(t *testing.T) {
+	for _, opts := range []interface{}{&VideoOpts{}, &UploadTokenOpts{}, &SessionVideoOpts{}} {
+		data, err := json.Marshal(opts)
+		if err != nil {
+			t.Fatalf("json.Marshal(%T) error: %v", opts, err)
+		}
+		err = json.Unmarshal(data, opts)
+		if err != nil {
+			t.Errorf("json.Unmarshal(%T) error: %v", opts, err)
+		}
+	}
+
+	var unset struct {
+		SortBy    SortField
+		SortOrder SortOrder
+		Period    Period
+	}
+	err := json.Unmarshal([]byte(`{"SortBy":"","SortOrder":"","Period":""}`), &unset)
+	if err != nil || unset.SortBy != "" || unset.SortOrder != "" || unset.Period != "" {
+		t.Errorf("json.Unmarshal of empty values got=%#v, %v", unset, err)
+	}
+}
+
+func TestVideos_ListInvalidOpts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Videos.List(&VideoOpts{SortBy: SortByTTL})
+	if err == nil {
+		t.Errorf("Videos.List should reject a sort field of upload tokens")
+	}
+
+	_, err = client.UploadTokens.List(&UploadTokenOpts{SortBy: SortByTitle})
+	if err == nil {
+		t.Errorf("UploadTokens.List should reject a sort field of videos")
+	}
+
+	_, err = client.Statistics.GetVideoSessions("vi4k0jvEUuaTdRAEjQ4Jfagz", &SessionVideoOpts{Period: "tomorrow"})
+	if err == nil {
+		t.Errorf("Statistics.GetVideoSessions should reject an invalid period")
+	}
+}
@@ -0,0 +1,142 @@
+package apivideosdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Sentinel errors, to be matched with errors.Is
+var (
+	// ErrNotFound matches API errors with a 404 status
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized matches API errors with a 401 or 403 status
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited matches API errors with a 429 status
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation matches API errors with a 400 or 422 status
+	// and the arguments rejected by the SDK before sending a request
+	ErrValidation = errors.New("validation failed")
+	// ErrInvalidID matches the ids rejected by the SDK before sending a request,
+	// they match ErrValidation too
+	ErrInvalidID = errors.New("invalid id")
+)
+
+// requestIDHeader is the response header identifying a request for the api.video support
+const requestIDHeader = "X-Request-Id"
+
+// ErrorResponse contains an error from the api.video API
+type ErrorResponse struct {
+	Response *http.Response
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Name     string `json:"name"`
+	// Status is the HTTP status of the error
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+	// InvalidParams lists the request parameters rejected by the API
+	InvalidParams []InvalidParam `json:"invalid-params"`
+	// Body is the raw body of the response
+	Body []byte `json:"-"`
+	// RequestID identifies the request, to be given to the api.video support
+	RequestID string `json:"-"`
+	// RateLimit is the rate limit of the response, if it has rate-limit headers
+	RateLimit *RateLimit `json:"-"`
+	// Attempts is the number of times the request was sent
+	Attempts int `json:"-"`
+}
+
+// InvalidParam is a request parameter rejected by the API
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := fmt.Sprintf(
+		"[%d]: %v %v\nType: %v\nTitle: %v\nName: %v",
+		r.Response.StatusCode,
+		r.Response.Request.Method,
+		r.Response.Request.URL,
+		r.Type,
+		r.Title,
+		r.Name,
+	)
+	if r.Detail != "" {
+		msg = fmt.Sprintf("%s\nDetail: %s", msg, r.Detail)
+	}
+	for _, param := range r.InvalidParams {
+		msg = fmt.Sprintf("%s\nInvalid param %s: %s", msg, param.Name, param.Reason)
+	}
+	if r.RequestID != "" {
+		msg = fmt.Sprintf("%s\nRequest ID: %s", msg, r.RequestID)
+	}
+	if r.Attempts > 1 {
+		msg = fmt.Sprintf("%s\nAttempts: %d", msg, r.Attempts)
+	}
+	return msg
+}
+
+// Is matches the sentinel error corresponding to the status of the response
+func (r *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return r.Status == http.StatusNotFound
+	case ErrUnauthorized:
+		return r.Status == http.StatusUnauthorized || r.Status == http.StatusForbidden
+	case ErrRateLimited:
+		return r.Status == http.StatusTooManyRequests
+	case ErrValidation:
+		return r.Status == http.StatusBadRequest || r.Status == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+func checkResponse(r *http.Response) error {
+	if c := r.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+	errorResponse := &ErrorResponse{Response: r, RequestID: r.Header.Get(requestIDHeader)}
+	if rateLimit, ok := ParseRateLimit(r.Header); ok {
+		errorResponse.RateLimit = &rateLimit
+	}
+	data, err := ioutil.ReadAll(r.Body)
+
+	if err == nil && len(data) > 0 {
+		errorResponse.Body = data
+		err := json.Unmarshal(data, errorResponse)
+		if err != nil {
+			errorResponse.Title = string(data)
+		}
+	}
+
+	// The status of the body is informative, the one of the response prevails
+	errorResponse.Status = r.StatusCode
+
+	return errorResponse
+}
+
+// validationError is an argument rejected by the SDK before sending a request
+type validationError struct {
+	msg string
+	// kind is a more specific sentinel error, like ErrInvalidID
+	kind error
+}
+
+func (e *validationError) Error() string {
+	return e.msg
+}
+
+func (e *validationError) Is(target error) bool {
+	return target == ErrValidation || (e.kind != nil && target == e.kind)
+}
+
+func invalidf(format string, args ...interface{}) error {
+	return &validationError{msg: fmt.Sprintf(format, args...)}
+}
+
+func invalidIDf(format string, args ...interface{}) error {
+	return &validationError{msg: fmt.Sprintf(format, args...), kind: ErrInvalidID}
+}
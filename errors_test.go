@@ -0,0 +1,103 @@
+package apivideosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestErrorResponse_Details(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{
+		"type": "https://docs.api.video/docs/attributeinvalid",
+		"title": "An attribute is invalid.",
+		"status": 400,
+		"detail": "The title must be a string.",
+		"name": "title",
+		"invalid-params": [{"name": "title", "reason": "This value should be of type string."}]
+	  }`
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, body)
+	})
+
+	_, err := client.Videos.Create(&VideoRequest{})
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Videos.Create error should be an *ErrorResponse, got %#v", err)
+	}
+	if !errors.Is(err, ErrValidation) || errors.Is(err, ErrNotFound) {
+		t.Errorf("Videos.Create error should only match ErrValidation, got %v", err)
+	}
+
+	expectedParams := []InvalidParam{{Name: "title", Reason: "This value should be of type string."}}
+	if errorResponse.Status != http.StatusBadRequest || errorResponse.Detail != "The title must be a string." || !reflect.DeepEqual(errorResponse.InvalidParams, expectedParams) {
+		t.Errorf("ErrorResponse fields got=%#v", errorResponse)
+	}
+	if string(errorResponse.Body) != body {
+		t.Errorf("ErrorResponse.Body got=%s want=%s", errorResponse.Body, body)
+	}
+	if errorResponse.RequestID != "req-123" || !strings.Contains(err.Error(), "Request ID: req-123") {
+		t.Errorf("ErrorResponse should keep the request id, got %q in %q", errorResponse.RequestID, err.Error())
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	setup()
+	defer teardown()
+
+	statuses := map[string]int{
+		"/videos/vi4k0jvEUuaTdRAEjQ4Jfagz": http.StatusNotFound,
+		"/videos/vi6HangYsow3vXxwdx3YMlAb": http.StatusForbidden,
+		"/videos/viNotJSON":                http.StatusTooManyRequests,
+	}
+	for path, status := range statuses {
+		status := status
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, "not json")
+		})
+	}
+
+	tests := []struct {
+		videoID  string
+		expected error
+	}{
+		{"vi4k0jvEUuaTdRAEjQ4Jfagz", ErrNotFound},
+		{"vi6HangYsow3vXxwdx3YMlAb", ErrUnauthorized},
+		{"viNotJSON", ErrRateLimited},
+	}
+
+	for _, test := range tests {
+		_, err := client.Videos.Get(test.videoID)
+		if !errors.Is(err, test.expected) {
+			t.Errorf("Videos.Get(%s) error got=%v want %v", test.videoID, err, test.expected)
+		}
+		var errorResponse *ErrorResponse
+		if errors.As(err, &errorResponse) && string(errorResponse.Body) != "not json" {
+			t.Errorf("ErrorResponse.Body got=%s want=%s", errorResponse.Body, "not json")
+		}
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Videos.Get("invalid")
+	if !errors.Is(err, ErrInvalidID) || !errors.Is(err, ErrValidation) {
+		t.Errorf("Videos.Get error should match ErrInvalidID and ErrValidation, got %v", err)
+	}
+
+	_, err = client.Videos.PickThumbnail("vi4k0jvEUuaTdRAEjQ4Jfagz", "1s")
+	if !errors.Is(err, ErrValidation) || errors.Is(err, ErrInvalidID) {
+		t.Errorf("Videos.PickThumbnail error should only match ErrValidation, got %v", err)
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/apivideo/go-sdk
 
-go 1.12
+go 1.13
 
 require github.com/google/go-querystring v1.0.0
@@ -0,0 +1,269 @@
+package apivideosdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Attribute keys of the spans and metrics of the client
+const (
+	AttrHTTPMethod   = "http.request.method"
+	AttrHTTPStatus   = "http.response.status_code"
+	AttrResourceType = "apivideo.resource.type"
+	AttrResourceID   = "apivideo.resource.id"
+	AttrChunkIndex   = "apivideo.upload.chunk.index"
+	AttrUploadSize   = "apivideo.upload.size"
+	AttrAttempts     = "apivideo.request.attempts"
+	AttrErrorType    = "error.type"
+)
+
+// Metric names of the client
+const (
+	// MetricRequestDuration is the histogram of the API calls duration, in seconds
+	MetricRequestDuration = "apivideo.client.request.duration"
+	// MetricUploadedBytes counts the bytes of the chunks accepted by the API
+	MetricUploadedBytes = "apivideo.client.uploaded_bytes"
+	// MetricErrors counts the failed API calls
+	MetricErrors = "apivideo.client.errors"
+)
+
+// Attribute is a key and value describing a span or a metric
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts the spans of the API calls and uploads.
+// An OpenTelemetry tracer can be adapted to it
+type Tracer interface {
+	// Start starts a span child of the span in ctx, and returns a context carrying it
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is an operation traced by a Tracer
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records the metrics of the client.
+// An OpenTelemetry meter can be adapted to it
+type Meter interface {
+	// Record adds value to the histogram name
+	Record(ctx context.Context, name string, value float64, attrs ...Attribute)
+	// Add adds value to the counter name
+	Add(ctx context.Context, name string, value int64, attrs ...Attribute)
+}
+
+// WithTracer traces every API call of the client, upload chunks included,
+// and every upload as the parent of its chunks
+func WithTracer(t Tracer) ClientOption {
+	return func(c *Client) {
+		c.tracer = t
+	}
+}
+
+// WithMeter records the duration and errors of the API calls of the client,
+// and the bytes uploaded
+func WithMeter(m Meter) ClientOption {
+	return func(c *Client) {
+		c.meter = m
+	}
+}
+
+type attributesKey struct{}
+
+// withAttributes returns a context carrying attrs for the spans of the
+// API calls sent with it, in addition to the attributes already carried
+func withAttributes(ctx context.Context, attrs ...Attribute) context.Context {
+	carried, _ := ctx.Value(attributesKey{}).([]Attribute)
+	return context.WithValue(ctx, attributesKey{}, mergeAttributes(carried, attrs))
+}
+
+// mergeAttributes returns attrs updated with the values of overrides
+func mergeAttributes(attrs []Attribute, overrides []Attribute) []Attribute {
+	merged := append([]Attribute{}, attrs...)
+	for _, override := range overrides {
+		replaced := false
+		for i := range merged {
+			if merged[i].Key == override.Key {
+				merged[i] = override
+				replaced = true
+			}
+		}
+		if !replaced {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
+func attributeValue(attrs []Attribute, key string) interface{} {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return nil
+}
+
+// resourceOf returns the type and id of the resource at u, the id is empty
+// for collections and upload tokens, whose id is a credential.
+// Analytics are attributed to the resource they describe
+func (c *Client) resourceOf(u *url.URL) (string, string) {
+	path := strings.TrimPrefix(u.Path, c.BaseURL.Path)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == statisticsBasePath && len(segments) > 1 {
+		segments = segments[1:]
+	}
+
+	if len(segments) > 1 && segments[0] != uploadTokensBasePath {
+		return segments[0], segments[1]
+	}
+	return segments[0], ""
+}
+
+// startCall starts the instrumentation of an API call, and returns req
+// carrying its span with the function ending it
+func (c *Client) startCall(req *http.Request) (*http.Request, func(*http.Response, error)) {
+	ctx := req.Context()
+
+	resourceType, resourceID := c.resourceOf(req.URL)
+	attrs := []Attribute{{AttrHTTPMethod, req.Method}, {AttrResourceType, resourceType}}
+	if resourceID != "" {
+		attrs = append(attrs, Attribute{AttrResourceID, resourceID})
+	}
+	carried, _ := ctx.Value(attributesKey{}).([]Attribute)
+	attrs = mergeAttributes(attrs, carried)
+
+	var span Span
+	if c.tracer != nil {
+		ctx, span = c.tracer.Start(ctx, "api.video "+req.Method+" "+resourceType, attrs...)
+		req = req.WithContext(ctx)
+	}
+
+	start := time.Now()
+	return req, func(resp *http.Response, err error) {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		errorResponse, isErrorResponse := err.(*ErrorResponse)
+		if isErrorResponse && errorResponse.Response != nil {
+			status = errorResponse.Response.StatusCode
+		}
+
+		// Resource ids are left out of the metrics, they would make one series per resource
+		metricAttrs := []Attribute{
+			{AttrHTTPMethod, req.Method},
+			{AttrResourceType, attributeValue(attrs, AttrResourceType)},
+		}
+		if status != 0 {
+			metricAttrs = append(metricAttrs, Attribute{AttrHTTPStatus, status})
+		}
+
+		if c.meter != nil {
+			c.meter.Record(ctx, MetricRequestDuration, time.Since(start).Seconds(), metricAttrs...)
+			if err != nil {
+				c.meter.Add(ctx, MetricErrors, 1, append(metricAttrs, Attribute{AttrErrorType, errorType(err)})...)
+			}
+		}
+
+		if span != nil {
+			if status != 0 {
+				span.SetAttributes(Attribute{AttrHTTPStatus, status})
+			}
+			if isErrorResponse && errorResponse.Attempts > 0 {
+				span.SetAttributes(Attribute{AttrAttempts, errorResponse.Attempts})
+			}
+			if err != nil {
+				span.RecordError(newRedactedError(err))
+			}
+			span.End()
+		}
+	}
+}
+
+// startUpload starts the span of an upload, parent of the spans of its chunks,
+// and returns a context carrying it with the function ending it
+func (c *Client) startUpload(ctx context.Context, u *chunkedUpload, v interface{}) (context.Context, func(error)) {
+	attrs := []Attribute{{AttrResourceType, videosBasePath}, {AttrUploadSize, u.size}}
+	if u.videoID != "" {
+		attrs = append(attrs, Attribute{AttrResourceID, u.videoID})
+	}
+	ctx = withAttributes(ctx, attrs[0])
+
+	if c.tracer == nil {
+		return ctx, func(error) {}
+	}
+
+	ctx, span := c.tracer.Start(ctx, "api.video upload", attrs...)
+	knownID := u.videoID != ""
+	return ctx, func(err error) {
+		// Delegated uploads learn the id of their video from the API
+		if video, ok := v.(*Video); ok && !knownID && video.VideoID != "" {
+			span.SetAttributes(Attribute{AttrResourceID, video.VideoID})
+		}
+		if err != nil {
+			span.RecordError(newRedactedError(err))
+		}
+		span.End()
+	}
+}
+
+// redactedError is an error whose message has the request URL redacted,
+// so upload tokens do not reach the tracing backend
+type redactedError struct {
+	msg string
+	err error
+}
+
+func newRedactedError(err error) error {
+	return &redactedError{msg: redactError(err), err: err}
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// Is matches the targets of the original error, without exposing its message
+func (e *redactedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+// recordUploaded records n bytes accepted by the API
+func (c *Client) recordUploaded(ctx context.Context, n int64) {
+	if c.meter != nil {
+		c.meter.Add(ctx, MetricUploadedBytes, n, Attribute{AttrResourceType, videosBasePath})
+	}
+}
+
+// errorType returns the kind of err for the error counts
+func errorType(err error) string {
+	// Transport errors wrap the context errors in a *url.Error
+	if errors.Is(err, context.Canceled) {
+		return context.Canceled.Error()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return context.DeadlineExceeded.Error()
+	}
+
+	switch err := err.(type) {
+	case *ErrorResponse:
+		if err.Response != nil {
+			return strconv.Itoa(err.Response.StatusCode)
+		}
+	case *url.Error:
+		if err.Timeout() {
+			return "timeout"
+		}
+	}
+
+	return "transport"
+}
@@ -0,0 +1,288 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type memorySpan struct {
+	tracer *memoryTracer
+	name   string
+	parent *memorySpan
+	attrs  []Attribute
+	err    error
+	ended  bool
+}
+
+func (s *memorySpan) SetAttributes(attrs ...Attribute) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.attrs = mergeAttributes(s.attrs, attrs)
+}
+
+func (s *memorySpan) RecordError(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.err = err
+}
+
+func (s *memorySpan) End() {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.ended = true
+}
+
+func (s *memorySpan) attr(key string) interface{} {
+	return attributeValue(s.attrs, key)
+}
+
+type spanKey struct{}
+
+// memoryTracer exports the spans to memory
+type memoryTracer struct {
+	mu    sync.Mutex
+	spans []*memorySpan
+}
+
+func (t *memoryTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	parent, _ := ctx.Value(spanKey{}).(*memorySpan)
+	span := &memorySpan{tracer: t, name: name, parent: parent, attrs: attrs}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// memoryMeter exports the metrics to memory
+type memoryMeter struct {
+	mu         sync.Mutex
+	histograms map[string][]float64
+	counters   map[string]int64
+	attrs      map[string][][]Attribute
+}
+
+func newMemoryMeter() *memoryMeter {
+	return &memoryMeter{histograms: map[string][]float64{}, counters: map[string]int64{}, attrs: map[string][][]Attribute{}}
+}
+
+func (m *memoryMeter) Record(ctx context.Context, name string, value float64, attrs ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.histograms[name] = append(m.histograms[name], value)
+	m.attrs[name] = append(m.attrs[name], attrs)
+}
+
+func (m *memoryMeter) Add(ctx context.Context, name string, value int64, attrs ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name] += value
+	m.attrs[name] = append(m.attrs[name], attrs)
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+	mux.HandleFunc("/live-streams/li400mYKSgQ6xs7taUeSaEKr", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":404,"title":"The requested resource was not found."}`)
+	})
+	mux.Handle("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", &reassemblyServer{t: t})
+
+	tracer := &memoryTracer{}
+	meter := newMemoryMeter()
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithChunkSize(1024), WithTracer(tracer), WithMeter(meter))
+
+	_, err := c.Videos.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if err != nil {
+		t.Fatalf("Videos.Get error: %v", err)
+	}
+	_, err = c.Livestreams.Get("li400mYKSgQ6xs7taUeSaEKr")
+	if err == nil {
+		t.Fatal("Livestreams.Get should fail")
+	}
+	_, err = c.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", bytes.NewReader(make([]byte, 2500)), 2500)
+	if err != nil {
+		t.Fatalf("Videos.UploadFromReader error: %v", err)
+	}
+
+	// get, failed get, upload and its 3 chunks
+	if len(tracer.spans) != 6 {
+		t.Fatalf("Tracer should have 6 spans, got %d", len(tracer.spans))
+	}
+	for _, span := range tracer.spans {
+		if !span.ended {
+			t.Errorf("Span %s should be ended", span.name)
+		}
+	}
+
+	get := tracer.spans[0]
+	if get.name != "api.video GET videos" || get.attr(AttrResourceType) != "videos" ||
+		get.attr(AttrResourceID) != "vi4k0jvEUuaTdRAEjQ4Jfagz" || get.attr(AttrHTTPStatus) != http.StatusOK || get.err != nil {
+		t.Errorf("Unexpected get span %s %v %v", get.name, get.attrs, get.err)
+	}
+
+	failed := tracer.spans[1]
+	if failed.attr(AttrResourceType) != "live-streams" || failed.attr(AttrHTTPStatus) != http.StatusNotFound || failed.err == nil {
+		t.Errorf("Unexpected failed span %s %v %v", failed.name, failed.attrs, failed.err)
+	}
+
+	upload := tracer.spans[2]
+	if upload.name != "api.video upload" || upload.attr(AttrResourceID) != "vi4k0jvEUuaTdRAEjQ4Jfagz" || upload.attr(AttrUploadSize) != int64(2500) {
+		t.Errorf("Unexpected upload span %s %v", upload.name, upload.attrs)
+	}
+	for i, chunk := range tracer.spans[3:] {
+		if chunk.parent != upload {
+			t.Errorf("Chunk span %d should be a child of the upload span", i)
+		}
+		if chunk.attr(AttrChunkIndex) != i || chunk.attr(AttrResourceID) != "vi4k0jvEUuaTdRAEjQ4Jfagz" || chunk.attr(AttrHTTPStatus) != http.StatusOK {
+			t.Errorf("Unexpected chunk span %d %v", i, chunk.attrs)
+		}
+	}
+
+	if n := len(meter.histograms[MetricRequestDuration]); n != 5 {
+		t.Errorf("Meter should record 5 durations, got %d", n)
+	}
+	if n := meter.counters[MetricUploadedBytes]; n != 2500 {
+		t.Errorf("Meter should count 2500 uploaded bytes, got %d", n)
+	}
+	if n := meter.counters[MetricErrors]; n != 1 {
+		t.Errorf("Meter should count 1 error, got %d", n)
+	}
+	if errorType := attributeValue(meter.attrs[MetricErrors][0], AttrErrorType); errorType != "404" {
+		t.Errorf("Error type should be 404, got %v", errorType)
+	}
+	for _, attrs := range meter.attrs[MetricRequestDuration] {
+		if attributeValue(attrs, AttrResourceID) != nil {
+			t.Errorf("Metrics should not have resource ids, got %v", attrs)
+		}
+	}
+}
+
+func TestClient_InstrumentationUploadToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/upload-tokens/toSECRET123", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"token":"toSECRET123","ttl":0}`)
+	})
+
+	tracer := &memoryTracer{}
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithTracer(tracer))
+
+	_, err := c.UploadTokens.Get("toSECRET123")
+	if err != nil {
+		t.Fatalf("UploadTokens.Get error: %v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Tracer should have 1 span, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.attr(AttrResourceType) != "upload-tokens" || span.attr(AttrResourceID) != nil {
+		t.Errorf("Upload token span should not carry the token, got %v", span.attrs)
+	}
+
+	// The recorded errors hold the request URL
+	mux.HandleFunc("/upload-tokens/toSECRET456", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":404,"title":"The requested resource was not found."}`)
+	})
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"status":403,"title":"Forbidden"}`)
+	})
+
+	err = c.UploadTokens.Delete("toSECRET456")
+	if err == nil {
+		t.Fatal("UploadTokens.Delete should fail")
+	}
+	u := NewDelegatedUploader("toSECRET789", WithBaseURL(baseURL), WithTracer(tracer))
+	_, err = u.UploadFromReader("video.mp4", bytes.NewReader([]byte("content")), 7)
+	if err == nil {
+		t.Fatal("DelegatedUploader.UploadFromReader should fail")
+	}
+
+	// delete, upload and its chunk
+	if len(tracer.spans) != 4 {
+		t.Fatalf("Tracer should have 4 spans, got %d", len(tracer.spans))
+	}
+	for _, span := range tracer.spans[1:] {
+		if span.err == nil {
+			t.Errorf("Span %s should record the error", span.name)
+			continue
+		}
+		for _, secret := range []string{"toSECRET456", "toSECRET789"} {
+			if strings.Contains(span.err.Error(), secret) {
+				t.Errorf("Span %s should not record the upload token, got %q", span.name, span.err)
+			}
+		}
+	}
+	if !errors.Is(tracer.spans[1].err, ErrNotFound) || !errors.Is(tracer.spans[2].err, ErrUnauthorized) {
+		t.Errorf("Recorded errors should match the sentinel errors, got %v and %v", tracer.spans[1].err, tracer.spans[2].err)
+	}
+}
+
+func TestDelegatedUploader_Instrumentation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.Handle("/upload", &reassemblyServer{t: t})
+
+	tracer := &memoryTracer{}
+	baseURL, _ := url.Parse(server.URL)
+	u := NewDelegatedUploader("to1tcmSFHeYY5KzyhOqVKMKb", WithBaseURL(baseURL), WithChunkSize(1024), WithTracer(tracer))
+
+	_, err := u.UploadFromReader("video.mp4", bytes.NewReader(make([]byte, 2048)), 2048)
+	if err != nil {
+		t.Fatalf("DelegatedUploader.UploadFromReader error: %v", err)
+	}
+
+	if len(tracer.spans) != 3 {
+		t.Fatalf("Tracer should have 3 spans, got %d", len(tracer.spans))
+	}
+	if upload := tracer.spans[0]; upload.attr(AttrResourceID) != "vi4k0jvEUuaTdRAEjQ4Jfagz" {
+		t.Errorf("Upload span should get the id of the created video, got %v", upload.attrs)
+	}
+	for i, chunk := range tracer.spans[1:] {
+		if chunk.attr(AttrResourceType) != "videos" {
+			t.Errorf("Chunk span %d should be attributed to videos, got %v", i, chunk.attrs)
+		}
+		if i > 0 && chunk.attr(AttrResourceID) != "vi4k0jvEUuaTdRAEjQ4Jfagz" {
+			t.Errorf("Chunk span %d should carry the video id, got %v", i, chunk.attrs)
+		}
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{&ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}, "404"},
+		{context.Canceled, "context canceled"},
+		{&url.Error{Op: "Get", URL: "https://ws.api.video/videos", Err: context.Canceled}, "context canceled"},
+		{&url.Error{Op: "Get", URL: "https://ws.api.video/videos", Err: context.DeadlineExceeded}, "context deadline exceeded"},
+		{&url.Error{Op: "Get", URL: "https://ws.api.video/videos", Err: fmt.Errorf("connection refused")}, "transport"},
+	}
+
+	for _, test := range tests {
+		if got := errorType(test.err); got != test.expected {
+			t.Errorf("errorType(%v) got=%s want=%s", test.err, got, test.expected)
+		}
+	}
+}
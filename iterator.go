@@ -0,0 +1,171 @@
+package apivideosdk
+
+import "context"
+
+// IterOption configures an iterator over a list endpoint
+type IterOption func(*iterOptions)
+
+type iterOptions struct {
+	maxItems int
+}
+
+// WithMaxItems stops the iteration after n items, 0 means no limit
+func WithMaxItems(n int) IterOption {
+	return func(o *iterOptions) {
+		o.maxItems = n
+	}
+}
+
+// pager walks through the pages of a list endpoint, fetching the next page
+// only once every item of the current one was consumed.
+// The typed iterators embed it and keep the items of the current page
+type pager struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, page int) (int, *Pagination, error)
+
+	// page is the next page to fetch
+	page int
+	// index is the position of the current item in a page of size items
+	index int
+	size  int
+	last  bool
+
+	maxItems int
+	count    int
+	done     bool
+	err      error
+}
+
+func newPager(ctx context.Context, page int, opts []IterOption, fetch func(ctx context.Context, page int) (int, *Pagination, error)) pager {
+	o := iterOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return pager{
+		ctx:      ctx,
+		fetch:    fetch,
+		page:     page,
+		maxItems: o.maxItems,
+	}
+}
+
+// Next advances to the next item, fetching the next page when needed.
+// It returns false once all the items were read, the item limit is reached,
+// a request failed or the context is done. Err tells these cases apart
+func (p *pager) Next() bool {
+	if p.done {
+		return false
+	}
+
+	if p.maxItems > 0 && p.count >= p.maxItems {
+		p.done = true
+		return false
+	}
+
+	err := p.ctx.Err()
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	p.index++
+	for p.index >= p.size {
+		if p.last {
+			p.done = true
+			return false
+		}
+
+		n, pagination, err := p.fetch(p.ctx, p.page)
+		if err != nil {
+			p.err = err
+			p.done = true
+			return false
+		}
+
+		p.size = n
+		p.index = 0
+		p.page++
+		p.last = n == 0 || pagination == nil || pagination.CurrentPage >= pagination.PagesTotal
+	}
+
+	p.count++
+	return true
+}
+
+// Err returns the error which stopped the iteration, if any
+func (p *pager) Err() error {
+	return p.err
+}
+
+// VideoIterator iterates over the videos of VideosService.List, see VideosService.Iter
+type VideoIterator struct {
+	pager
+	items []Video
+}
+
+// Video returns the current video
+func (it *VideoIterator) Video() *Video {
+	return &it.items[it.index]
+}
+
+// LivestreamIterator iterates over the livestreams of LivestreamsService.List, see LivestreamsService.Iter
+type LivestreamIterator struct {
+	pager
+	items []Livestream
+}
+
+// Livestream returns the current livestream
+func (it *LivestreamIterator) Livestream() *Livestream {
+	return &it.items[it.index]
+}
+
+// PlayerIterator iterates over the players of PlayersService.List, see PlayersService.Iter
+type PlayerIterator struct {
+	pager
+	items []Player
+}
+
+// Player returns the current player
+func (it *PlayerIterator) Player() *Player {
+	return &it.items[it.index]
+}
+
+// UploadTokenIterator iterates over the tokens of UploadTokensService.List, see UploadTokensService.Iter
+type UploadTokenIterator struct {
+	pager
+	items []UploadToken
+}
+
+// UploadToken returns the current upload token
+func (it *UploadTokenIterator) UploadToken() *UploadToken {
+	return &it.items[it.index]
+}
+
+// StatisticIterator iterates over the sessions of a statistics list,
+// see StatisticsService.IterVideoSessions and StatisticsService.IterLivestreamSessions
+type StatisticIterator struct {
+	pager
+	items []Statistic
+}
+
+// Statistic returns the current session
+func (it *StatisticIterator) Statistic() *Statistic {
+	return &it.items[it.index]
+}
+
+// SessionEventIterator iterates over the events of a session, see StatisticsService.IterSessionEvents
+type SessionEventIterator struct {
+	pager
+	items []SessionEvent
+}
+
+// SessionEvent returns the current event
+func (it *SessionEventIterator) SessionEvent() *SessionEvent {
+	return &it.items[it.index]
+}
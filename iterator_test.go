@@ -0,0 +1,168 @@
+package apivideosdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// pagedHandler serves items two per page, recording the requested pages
+func pagedHandler(t *testing.T, items []string, pages *[]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		page, _ := strconv.Atoi(r.URL.Query().Get("currentPage"))
+		*pages = append(*pages, page)
+
+		pagesTotal := (len(items) + 1) / 2
+		data := "[]"
+		if page >= 1 && page <= pagesTotal {
+			end := page * 2
+			if end > len(items) {
+				end = len(items)
+			}
+			data = "["
+			for i, item := range items[(page-1)*2 : end] {
+				if i > 0 {
+					data += ","
+				}
+				data += item
+			}
+			data += "]"
+		}
+		fmt.Fprintf(w, `{"data":%s, "pagination":{"currentPage":%d, "pageSize":2, "pagesTotal":%d}}`, data, page, pagesTotal)
+	}
+}
+
+func TestVideos_Iter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []int
+	items := []string{videoJSONResponses[0], videoJSONResponses[1], videoJSONResponses[0]}
+	mux.HandleFunc("/videos", pagedHandler(t, items, &pages))
+
+	it := client.Videos.Iter(&VideoOpts{PageSize: 2})
+	if len(pages) != 0 {
+		t.Errorf("Videos.Iter should not fetch before Next, fetched %v", pages)
+	}
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Video().VideoID)
+	}
+	if it.Err() != nil {
+		t.Errorf("Videos.Iter error: %v", it.Err())
+	}
+
+	expected := []string{videoStructs[0].VideoID, videoStructs[1].VideoID, videoStructs[0].VideoID}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Videos.Iter\n got=%#v\nwant=%#v", ids, expected)
+	}
+	if !reflect.DeepEqual(pages, []int{1, 2}) {
+		t.Errorf("Videos.Iter pages got=%v want=%v", pages, []int{1, 2})
+	}
+}
+
+func TestVideos_IterMaxItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []int
+	items := []string{videoJSONResponses[0], videoJSONResponses[1], videoJSONResponses[0]}
+	mux.HandleFunc("/videos", pagedHandler(t, items, &pages))
+
+	it := client.Videos.Iter(nil, WithMaxItems(2))
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil {
+		t.Errorf("Videos.Iter error: %v", it.Err())
+	}
+	if count != 2 {
+		t.Errorf("Videos.Iter should stop after 2 items, got %d", count)
+	}
+	if !reflect.DeepEqual(pages, []int{1}) {
+		t.Errorf("Videos.Iter should not fetch pages past the limit, fetched %v", pages)
+	}
+}
+
+func TestVideos_IterError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []int
+	items := []string{videoJSONResponses[0], videoJSONResponses[1], videoJSONResponses[0]}
+	paged := pagedHandler(t, items, &pages)
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("currentPage") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		paged(w, r)
+	})
+
+	it := client.Videos.Iter(nil)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Videos.Iter should return the items of the first page, got %d", count)
+	}
+	if it.Err() == nil {
+		t.Errorf("Videos.Iter should report the error of the second page")
+	}
+	if it.Next() {
+		t.Errorf("Videos.Iter should stop after an error")
+	}
+}
+
+func TestVideos_IterCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []int
+	items := []string{videoJSONResponses[0], videoJSONResponses[1], videoJSONResponses[0]}
+	mux.HandleFunc("/videos", pagedHandler(t, items, &pages))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := client.Videos.IterWithContext(ctx, nil)
+	if !it.Next() {
+		t.Fatalf("Videos.Iter error: %v", it.Err())
+	}
+	cancel()
+
+	if it.Next() {
+		t.Errorf("Videos.Iter should stop once the context is cancelled")
+	}
+	if it.Err() != context.Canceled {
+		t.Errorf("Videos.Iter error got=%v want=%v", it.Err(), context.Canceled)
+	}
+}
+
+func TestStatistics_IterVideoSessions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []int
+	items := []string{statsJSONResponses[0], statsJSONResponses[1], statsJSONResponses[0]}
+	mux.HandleFunc("/analytics/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", pagedHandler(t, items, &pages))
+
+	it := client.Statistics.IterVideoSessions("vi4k0jvEUuaTdRAEjQ4Jfagz", &SessionVideoOpts{Period: "2019-01"})
+	var sessions []Statistic
+	for it.Next() {
+		sessions = append(sessions, *it.Statistic())
+	}
+	if it.Err() != nil {
+		t.Errorf("Statistics.IterVideoSessions error: %v", it.Err())
+	}
+
+	expected := []Statistic{statsStructs[0], statsStructs[1], statsStructs[0]}
+	if !reflect.DeepEqual(sessions, expected) {
+		t.Errorf("Statistics.IterVideoSessions\n got=%#v\nwant=%#v", sessions, expected)
+	}
+}
@@ -1,7 +1,9 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -14,12 +16,23 @@ const livestreamsBasePath = "live-streams"
 // See: https://docs.api.video/5.1/live
 type LivestreamsServiceI interface {
 	Get(livestreamID string) (*Livestream, error)
+	GetWithContext(ctx context.Context, livestreamID string) (*Livestream, error)
 	List(opts *LivestreamOpts) (*LivestreamList, error)
+	ListWithContext(ctx context.Context, opts *LivestreamOpts) (*LivestreamList, error)
+	Iter(opts *LivestreamOpts, iterOpts ...IterOption) *LivestreamIterator
+	IterWithContext(ctx context.Context, opts *LivestreamOpts, iterOpts ...IterOption) *LivestreamIterator
 	Create(createRequest *LivestreamRequest) (*Livestream, error)
+	CreateWithContext(ctx context.Context, createRequest *LivestreamRequest) (*Livestream, error)
 	Update(livestreamID string, updateRequest *LivestreamRequest) (*Livestream, error)
+	UpdateWithContext(ctx context.Context, livestreamID string, updateRequest *LivestreamRequest) (*Livestream, error)
 	Delete(livestreamID string) error
+	DeleteWithContext(ctx context.Context, livestreamID string) error
 	UploadThumbnail(livestreamID string, filePath string) (*Livestream, error)
+	UploadThumbnailWithContext(ctx context.Context, livestreamID string, filePath string) (*Livestream, error)
+	UploadThumbnailFromReader(livestreamID string, name string, r io.Reader) (*Livestream, error)
+	UploadThumbnailFromReaderWithContext(ctx context.Context, livestreamID string, name string, r io.Reader) (*Livestream, error)
 	DeleteThumbnail(livestreamID string) (*Livestream, error)
+	DeleteThumbnailWithContext(ctx context.Context, livestreamID string) (*Livestream, error)
 }
 
 // LivestreamsService communicating with the Livestream
@@ -47,8 +60,9 @@ type LivestreamList struct {
 
 // LivestreamRequest represents a request to create / update a Livestream
 type LivestreamRequest struct {
-	Name     string `json:"name,omitempty"`
-	Record   bool   `json:"record"`
+	Name string `json:"name,omitempty"`
+	// Record is only sent when set, use Bool to set it
+	Record   *bool  `json:"record,omitempty"`
 	PlayerID string `json:"playerId,omitempty"`
 }
 
@@ -61,6 +75,11 @@ type LivestreamOpts struct {
 
 //Get returns a Livestream by id
 func (s *LivestreamsService) Get(livestreamID string) (*Livestream, error) {
+	return s.GetWithContext(context.Background(), livestreamID)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *LivestreamsService) GetWithContext(ctx context.Context, livestreamID string) (*Livestream, error) {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
@@ -69,7 +88,7 @@ func (s *LivestreamsService) Get(livestreamID string) (*Livestream, error) {
 
 	path := fmt.Sprintf("%s/%s", livestreamsBasePath, livestreamID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +105,11 @@ func (s *LivestreamsService) Get(livestreamID string) (*Livestream, error) {
 
 //List returns a LivestreamList containing all livestreams
 func (s *LivestreamsService) List(opts *LivestreamOpts) (*LivestreamList, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *LivestreamsService) ListWithContext(ctx context.Context, opts *LivestreamOpts) (*LivestreamList, error) {
 
 	v, err := query.Values(opts)
 
@@ -96,7 +120,7 @@ func (s *LivestreamsService) List(opts *LivestreamOpts) (*LivestreamList, error)
 
 	path := fmt.Sprintf("%s?%s", livestreamsBasePath, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -111,10 +135,41 @@ func (s *LivestreamsService) List(opts *LivestreamOpts) (*LivestreamList, error)
 	return ll, nil
 }
 
+//Iter returns a LivestreamIterator over all livestreams matching LivestreamOpts, the pages are fetched as needed
+func (s *LivestreamsService) Iter(opts *LivestreamOpts, iterOpts ...IterOption) *LivestreamIterator {
+	return s.IterWithContext(context.Background(), opts, iterOpts...)
+}
+
+//IterWithContext is the same as Iter with a context controlling the lifetime of the requests
+func (s *LivestreamsService) IterWithContext(ctx context.Context, opts *LivestreamOpts, iterOpts ...IterOption) *LivestreamIterator {
+	o := LivestreamOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(LivestreamIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.ListWithContext(ctx, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
 //Create a livestream container and returns it
 func (s *LivestreamsService) Create(createRequest *LivestreamRequest) (*Livestream, error) {
+	return s.CreateWithContext(context.Background(), createRequest)
+}
+
+//CreateWithContext is the same as Create with a context controlling the lifetime of the request
+func (s *LivestreamsService) CreateWithContext(ctx context.Context, createRequest *LivestreamRequest) (*Livestream, error) {
 
-	req, err := s.client.prepareRequest(http.MethodPost, livestreamsBasePath, createRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, livestreamsBasePath, createRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +186,11 @@ func (s *LivestreamsService) Create(createRequest *LivestreamRequest) (*Livestre
 
 //Update a video container and returns it
 func (s *LivestreamsService) Update(livestreamID string, updateRequest *LivestreamRequest) (*Livestream, error) {
+	return s.UpdateWithContext(context.Background(), livestreamID, updateRequest)
+}
+
+//UpdateWithContext is the same as Update with a context controlling the lifetime of the request
+func (s *LivestreamsService) UpdateWithContext(ctx context.Context, livestreamID string, updateRequest *LivestreamRequest) (*Livestream, error) {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
@@ -139,7 +199,7 @@ func (s *LivestreamsService) Update(livestreamID string, updateRequest *Livestre
 
 	path := fmt.Sprintf("%s/%s", livestreamsBasePath, livestreamID)
 
-	req, err := s.client.prepareRequest(http.MethodPatch, path, updateRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, path, updateRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +216,11 @@ func (s *LivestreamsService) Update(livestreamID string, updateRequest *Livestre
 
 //Delete a livestream container
 func (s *LivestreamsService) Delete(livestreamID string) error {
+	return s.DeleteWithContext(context.Background(), livestreamID)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *LivestreamsService) DeleteWithContext(ctx context.Context, livestreamID string) error {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
@@ -164,7 +229,7 @@ func (s *LivestreamsService) Delete(livestreamID string) error {
 
 	path := fmt.Sprintf("%s/%s", livestreamsBasePath, livestreamID)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
@@ -180,6 +245,28 @@ func (s *LivestreamsService) Delete(livestreamID string) error {
 
 //UploadThumbnail upload the thumbnail of a livestream
 func (s *LivestreamsService) UploadThumbnail(livestreamID string, filePath string) (*Livestream, error) {
+	return s.UploadThumbnailWithContext(context.Background(), livestreamID, filePath)
+}
+
+//UploadThumbnailWithContext is the same as UploadThumbnail with a context controlling the lifetime of the request
+func (s *LivestreamsService) UploadThumbnailWithContext(ctx context.Context, livestreamID string, filePath string) (*Livestream, error) {
+
+	file, name, _, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadThumbnailFromReaderWithContext(ctx, livestreamID, name, file)
+}
+
+//UploadThumbnailFromReader upload the thumbnail of a livestream from r, name is the file name sent to the API
+func (s *LivestreamsService) UploadThumbnailFromReader(livestreamID string, name string, r io.Reader) (*Livestream, error) {
+	return s.UploadThumbnailFromReaderWithContext(context.Background(), livestreamID, name, r)
+}
+
+//UploadThumbnailFromReaderWithContext is the same as UploadThumbnailFromReader with a context controlling the lifetime of the request
+func (s *LivestreamsService) UploadThumbnailFromReaderWithContext(ctx context.Context, livestreamID string, name string, r io.Reader) (*Livestream, error) {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
@@ -188,7 +275,7 @@ func (s *LivestreamsService) UploadThumbnail(livestreamID string, filePath strin
 
 	path := fmt.Sprintf("%s/%s/thumbnail", livestreamsBasePath, livestreamID)
 
-	req, err := s.client.prepareUploadRequest(path, filePath, nil)
+	req, err := s.client.prepareUploadRequest(ctx, path, name, r, nil)
 
 	if err != nil {
 		return nil, err
@@ -207,6 +294,11 @@ func (s *LivestreamsService) UploadThumbnail(livestreamID string, filePath strin
 
 //DeleteThumbnail upload the thumbnail of a livestream
 func (s *LivestreamsService) DeleteThumbnail(livestreamID string) (*Livestream, error) {
+	return s.DeleteThumbnailWithContext(context.Background(), livestreamID)
+}
+
+//DeleteThumbnailWithContext is the same as DeleteThumbnail with a context controlling the lifetime of the request
+func (s *LivestreamsService) DeleteThumbnailWithContext(ctx context.Context, livestreamID string) (*Livestream, error) {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
@@ -215,7 +307,7 @@ func (s *LivestreamsService) DeleteThumbnail(livestreamID string) (*Livestream,
 
 	path := fmt.Sprintf("%s/%s/thumbnail", livestreamsBasePath, livestreamID)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return nil, err
 	}
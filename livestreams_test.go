@@ -75,7 +75,7 @@ var liveRequestJSON = `{
 
 var liveRequestStruct = LivestreamRequest{
 	Name:     "Test live",
-	Record:   true,
+	Record:   Bool(true),
 	PlayerID: "pl4f4ferf5erfr5zed4fsdd",
 }
 
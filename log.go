@@ -0,0 +1,209 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redacted replaces the secrets in logs
+const redacted = "[REDACTED]"
+
+// secretFields are the JSON fields and query parameters holding secrets:
+// the API key sent to authenticate, the tokens and the livestream keys
+var secretFields = map[string]bool{
+	"apiKey":        true,
+	"refreshToken":  true,
+	"access_token":  true,
+	"refresh_token": true,
+	"streamKey":     true,
+	"token":         true,
+}
+
+// Logger receives the logs of a client as a message and alternating keys and values.
+// *slog.Logger satisfies it
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// WithLogger logs every attempt of every request sent by the client: method, path,
+// status, latency, attempt and payload sizes. Failed attempts are logged as errors.
+// Secrets are redacted: the Authorization header, the API key and tokens of the
+// authentication, the upload tokens and the livestream stream keys
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithBodyLogging adds the JSON bodies of requests and responses to the logs,
+// with their secrets redacted and truncated to maxBytes
+func WithBodyLogging(maxBytes int) ClientOption {
+	return func(c *Client) {
+		c.logBodies = maxBytes
+	}
+}
+
+// logRoundTrip logs one attempt of req
+func (c *Client) logRoundTrip(req *http.Request, attempt int, resp *http.Response, err error, latency time.Duration) {
+	args := []interface{}{
+		"method", req.Method,
+		"path", redactURL(req.URL),
+		"attempt", attempt,
+		"latency", latency,
+		"requestBytes", req.ContentLength,
+		"requestHeaders", redactHeaders(req.Header),
+	}
+
+	// Requests without body still have the JSON Content-Type
+	if c.logBodies > 0 && req.ContentLength != 0 && req.GetBody != nil && isJSON(req.Header) {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := ioutil.ReadAll(body)
+			body.Close()
+			args = append(args, "requestBody", redactBody(data, c.logBodies))
+		}
+	}
+
+	if err != nil {
+		c.logger.Error("api.video request failed", append(args, "error", redactError(err))...)
+		return
+	}
+
+	args = append(args, "status", resp.StatusCode, "responseBytes", resp.ContentLength)
+	if requestID := resp.Header.Get(requestIDHeader); requestID != "" {
+		args = append(args, "requestId", requestID)
+	}
+
+	// The API answers in JSON, whatever the Content-Type of the response
+	if c.logBodies > 0 && resp.Body != nil {
+		data, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+		if readErr == nil {
+			args = append(args, "responseBody", redactBody(data, c.logBodies))
+		}
+	}
+
+	if resp.StatusCode >= 400 {
+		c.logger.Error("api.video request failed", args...)
+		return
+	}
+	c.logger.Debug("api.video request", args...)
+}
+
+func isJSON(h http.Header) bool {
+	return strings.Contains(h.Get("Content-Type"), "json")
+}
+
+// redactURL returns the path and query of u with the upload tokens
+// and the secret parameters redacted
+func redactURL(u *url.URL) string {
+	path := redactPath(u.Path)
+	if u.RawQuery == "" {
+		return path
+	}
+
+	query := u.Query()
+	for key := range query {
+		if secretFields[key] {
+			query.Set(key, redacted)
+		}
+	}
+	return path + "?" + query.Encode()
+}
+
+// redactPath returns path with the upload tokens following upload-tokens/ redacted
+func redactPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == uploadTokensBasePath && segments[i+1] != "" {
+			segments[i+1] = redacted
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// redactFullURL returns u with the upload tokens and the secret parameters redacted
+func redactFullURL(u *url.URL) string {
+	if u.Host == "" {
+		return redactURL(u)
+	}
+	return u.Scheme + "://" + u.Host + redactURL(u)
+}
+
+// redactError returns the message of err with the request URL redacted,
+// as *url.Error and *ErrorResponse messages contain it
+func redactError(err error) string {
+	msg := err.Error()
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			msg = strings.Replace(msg, urlErr.URL, redactFullURL(u), -1)
+		}
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.Request != nil {
+		u := errorResponse.Response.Request.URL
+		msg = strings.Replace(msg, u.String(), redactFullURL(u), -1)
+	}
+
+	return msg
+}
+
+// redactHeaders returns the headers with the credentials redacted
+func redactHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for key, values := range h {
+		value := strings.Join(values, ", ")
+		if key == "Authorization" || key == "Cookie" {
+			value = redacted
+		}
+		headers[key] = value
+	}
+	return headers
+}
+
+// redactBody returns a JSON body with the values of its secret fields redacted,
+// truncated to maxBytes
+func redactBody(data []byte, maxBytes int) string {
+	var v interface{}
+	if json.Unmarshal(data, &v) == nil {
+		if redactedData, err := json.Marshal(redactValue(v)); err == nil {
+			data = redactedData
+		}
+	} else {
+		// A body which cannot be parsed cannot be redacted
+		data = []byte(redacted)
+	}
+
+	if len(data) > maxBytes {
+		return string(data[:maxBytes]) + "..."
+	}
+	return string(data)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if secretFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,207 @@
+package apivideosdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type logEntry struct {
+	level string
+	msg   string
+	attrs map[string]interface{}
+}
+
+type memoryLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *memoryLogger) log(level, msg string, args []interface{}) {
+	attrs := make(map[string]interface{})
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[args[i].(string)] = args[i+1]
+	}
+	l.mu.Lock()
+	l.entries = append(l.entries, logEntry{level, msg, attrs})
+	l.mu.Unlock()
+}
+
+func (l *memoryLogger) Debug(msg string, args ...interface{}) { l.log("debug", msg, args) }
+func (l *memoryLogger) Error(msg string, args ...interface{}) { l.log("error", msg, args) }
+
+func TestClient_Logger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/live-streams/li400mYKSgQ6xs7taUeSaEKr", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"liveStreamId":"li400mYKSgQ6xs7taUeSaEKr","streamKey":"secretStreamKey","name":"live"}`)
+	})
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":404,"title":"The requested resource was not found."}`)
+	})
+
+	logger := &memoryLogger{}
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("secretApiKey", WithBaseURL(baseURL), WithLogger(logger), WithBodyLogging(1024))
+
+	_, err := c.Livestreams.Get("li400mYKSgQ6xs7taUeSaEKr")
+	if err != nil {
+		t.Fatalf("Livestreams.Get error: %v", err)
+	}
+	_, err = c.Videos.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if err == nil {
+		t.Fatal("Videos.Get should fail")
+	}
+
+	if len(logger.entries) != 3 {
+		t.Fatalf("Logger should receive 3 entries, got %d: %v", len(logger.entries), logger.entries)
+	}
+
+	auth := logger.entries[0].attrs
+	if auth["method"] != http.MethodPost || auth["path"] != "/auth/api-key" || auth["status"] != http.StatusOK || auth["attempt"] != 1 {
+		t.Errorf("Unexpected auth entry %v", auth)
+	}
+	if auth["requestBytes"].(int64) <= 0 {
+		t.Errorf("Auth entry should have the request size, got %v", auth["requestBytes"])
+	}
+
+	livestream := logger.entries[1]
+	if livestream.level != "debug" || livestream.attrs["path"] != "/live-streams/li400mYKSgQ6xs7taUeSaEKr" {
+		t.Errorf("Unexpected livestream entry %v", livestream)
+	}
+	if headers := livestream.attrs["requestHeaders"].(map[string]string); headers["Authorization"] != redacted {
+		t.Errorf("Authorization header should be redacted, got %v", headers["Authorization"])
+	}
+	if _, ok := livestream.attrs["latency"]; !ok {
+		t.Error("Livestream entry should have the latency")
+	}
+
+	video := logger.entries[2]
+	if video.level != "error" || video.attrs["status"] != http.StatusNotFound || video.attrs["requestId"] != "req-123" {
+		t.Errorf("Unexpected video entry %v", video)
+	}
+
+	for _, entry := range logger.entries {
+		logged := fmt.Sprint(entry.attrs)
+		for _, secret := range []string{"secretApiKey", "secretStreamKey", "fakeToken"} {
+			if strings.Contains(logged, secret) {
+				t.Errorf("Logs should not contain %s: %s", secret, logged)
+			}
+		}
+	}
+	if _, ok := livestream.attrs["requestBody"]; ok {
+		t.Errorf("Requests without body should not log a body, got %v", livestream.attrs["requestBody"])
+	}
+	if _, ok := auth["requestBody"]; !ok {
+		t.Error("Auth entry should log the redacted request body")
+	}
+	if body := logger.entries[1].attrs["responseBody"].(string); !strings.Contains(body, "li400mYKSgQ6xs7taUeSaEKr") {
+		t.Errorf("Response body should be logged, got %s", body)
+	}
+}
+
+func TestClient_LoggerUploadToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/upload-tokens/toSECRET123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"token":"toSECRET123","ttl":0}`)
+	})
+
+	logger := &memoryLogger{}
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithLogger(logger), WithBodyLogging(1024))
+
+	_, err := c.UploadTokens.Get("toSECRET123")
+	if err != nil {
+		t.Fatalf("UploadTokens.Get error: %v", err)
+	}
+	err = c.UploadTokens.Delete("toSECRET123")
+	if err != nil {
+		t.Fatalf("UploadTokens.Delete error: %v", err)
+	}
+
+	if len(logger.entries) != 3 {
+		t.Fatalf("Logger should receive 3 entries, got %d", len(logger.entries))
+	}
+	for _, entry := range logger.entries[1:] {
+		if entry.attrs["path"] != "/upload-tokens/"+redacted {
+			t.Errorf("Upload token should be redacted from the path, got %v", entry.attrs["path"])
+		}
+		if logged := fmt.Sprint(entry.attrs); strings.Contains(logged, "toSECRET123") {
+			t.Errorf("Logs should not contain the upload token: %s", logged)
+		}
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_LoggerTransportError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Only the authentication reaches the server
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/auth/api-key" {
+			return http.DefaultTransport.RoundTrip(req)
+		}
+		return nil, errors.New("boom")
+	})
+
+	logger := &memoryLogger{}
+	baseURL, _ := url.Parse(server.URL)
+	opts := []ClientOption{WithBaseURL(baseURL), WithHTTPClient(&http.Client{Transport: transport}), WithLogger(logger), WithRetryPolicy(RetryPolicy{MaxAttempts: 1})}
+
+	u := NewDelegatedUploader("to1secret", opts...)
+	_, err := u.UploadFromReader("video.mp4", strings.NewReader("content"), 7)
+	if err == nil {
+		t.Fatal("DelegatedUploader.UploadFromReader should fail")
+	}
+
+	c := NewClient("apiKey", opts...)
+	_, err = c.UploadTokens.Get("to2secret")
+	if err == nil {
+		t.Fatal("UploadTokens.Get should fail")
+	}
+
+	failures := 0
+	for _, entry := range logger.entries {
+		if entry.level != "error" {
+			continue
+		}
+		failures++
+		message, _ := entry.attrs["error"].(string)
+		if !strings.Contains(message, "boom") || !strings.Contains(message, "REDACTED") {
+			t.Errorf("Error should be logged with the URL redacted, got %q", message)
+		}
+		if logged := fmt.Sprint(entry.attrs); strings.Contains(logged, "to1secret") || strings.Contains(logged, "to2secret") {
+			t.Errorf("Logs should not contain the upload token: %s", logged)
+		}
+	}
+	if failures != 2 {
+		t.Errorf("Logger should receive 2 errors, got %d", failures)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://ws.api.video/upload?token=to1tcmSFHeYY5KzyhOqVKMKb&name=video")
+	expected := "/upload?name=video&token=%5BREDACTED%5D"
+	if got := redactURL(u); got != expected {
+		t.Errorf("redactURL = %s, expected %s", got, expected)
+	}
+}
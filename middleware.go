@@ -0,0 +1,37 @@
+package apivideosdk
+
+import "net/http"
+
+// Doer sends an HTTP request, *http.Client is a Doer
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is a function usable as a Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending the requests of a client, to observe or modify
+// requests and responses. It sees every attempt of every request: API calls,
+// upload chunks and authentication
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middlewares to the client, the first one is the outermost:
+// it sees the requests first and the responses last
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// chain wraps doer in the middlewares, the first one being the outermost
+func chain(doer Doer, middlewares []Middleware) Doer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
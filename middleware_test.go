@@ -0,0 +1,128 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var seen []string
+	record := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			if err == nil {
+				mu.Lock()
+				seen = append(seen, fmt.Sprintf("%s %s %d", req.Method, req.URL.Path, resp.StatusCode))
+				mu.Unlock()
+			}
+			return resp, err
+		})
+	}
+	tenant := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Tenant-Id", "tenant1")
+			return next.Do(req)
+		})
+	}
+
+	checkTenant := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("X-Tenant-Id") != "tenant1" {
+			t.Errorf("Request %s should carry the tenant header", r.URL.Path)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		checkTenant(w, r)
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		checkTenant(w, r)
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithMiddleware(record, tenant))
+
+	_, err := c.Videos.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if err != nil {
+		t.Fatalf("Videos.Get error: %v", err)
+	}
+	_, err = c.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", bytes.NewReader(make([]byte, 1024)), 1024)
+	if err != nil {
+		t.Fatalf("Videos.UploadFromReader error: %v", err)
+	}
+
+	expected := []string{
+		"POST /auth/api-key 200",
+		"GET /videos/vi4k0jvEUuaTdRAEjQ4Jfagz 200",
+		"POST /videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source 200",
+	}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("Middleware should see auth, JSON and upload requests\n got=%#v\nwant=%#v", seen, expected)
+	}
+}
+
+func TestClient_MiddlewareWrapsOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	wraps := 0
+	counting := func(next Doer) Doer {
+		wraps++
+		return next
+	}
+
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithMiddleware(counting))
+
+	for i := 0; i < 3; i++ {
+		_, err := c.Account.Get()
+		if err != nil {
+			t.Fatalf("Account.Get error: %v", err)
+		}
+	}
+
+	if wraps != 1 {
+		t.Errorf("Middleware should wrap the client once, got %d wraps", wraps)
+	}
+}
+
+func TestChain_Order(t *testing.T) {
+	var order []string
+	named := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" in")
+				resp, err := next.Do(req)
+				order = append(order, name+" out")
+				return resp, err
+			})
+		}
+	}
+	base := DoerFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "send")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://ws.api.video", nil)
+	chain(base, []Middleware{named("first"), named("second")}).Do(req)
+
+	expected := []string{"first in", "second in", "send", "second out", "first out"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("chain order\n got=%#v\nwant=%#v", order, expected)
+	}
+}
@@ -0,0 +1,445 @@
+package apivideosdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNoPage is returned when following a page link missing from the pagination,
+// like the next page of the last page
+var ErrNoPage = errors.New("no such page")
+
+// Link returns the URI of the link with the given rel, such as "next" or "last"
+func (p *Pagination) Link(rel string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	for _, link := range p.Links {
+		if link.Rel == rel || (rel == "previous" && link.Rel == "prev") {
+			return link.URI, true
+		}
+	}
+	return "", false
+}
+
+// followLink fills v with the page linked with rel from p.
+// Only the path and query of the link are used, relative to the client base URL,
+// so the request is always sent with the client credentials to the client base URL
+func (c *Client) followLink(ctx context.Context, p *Pagination, rel string, v interface{}) error {
+	uri, ok := p.Link(rel)
+	if !ok {
+		return ErrNoPage
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	// The API links are absolute paths, unaware of a path prefix of the base URL
+	path := strings.TrimPrefix(u.Path, c.BaseURL.Path)
+	ref := &url.URL{Path: strings.TrimPrefix(path, "/"), RawQuery: u.RawQuery}
+
+	req, err := c.prepareRequest(ctx, http.MethodGet, ref.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(req, v)
+
+	return err
+}
+
+//NextPage returns the next page of videos, or ErrNoPage on the last page
+func (l *VideoList) NextPage(c *Client) (*VideoList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *VideoList) NextPageWithContext(ctx context.Context, c *Client) (*VideoList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of videos, or ErrNoPage on the first page
+func (l *VideoList) PrevPage(c *Client) (*VideoList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *VideoList) PrevPageWithContext(ctx context.Context, c *Client) (*VideoList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of videos
+func (l *VideoList) FirstPage(c *Client) (*VideoList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *VideoList) FirstPageWithContext(ctx context.Context, c *Client) (*VideoList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of videos
+func (l *VideoList) LastPage(c *Client) (*VideoList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *VideoList) LastPageWithContext(ctx context.Context, c *Client) (*VideoList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *VideoList) page(ctx context.Context, c *Client, rel string) (*VideoList, error) {
+	page := new(VideoList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of livestreams, or ErrNoPage on the last page
+func (l *LivestreamList) NextPage(c *Client) (*LivestreamList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *LivestreamList) NextPageWithContext(ctx context.Context, c *Client) (*LivestreamList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of livestreams, or ErrNoPage on the first page
+func (l *LivestreamList) PrevPage(c *Client) (*LivestreamList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *LivestreamList) PrevPageWithContext(ctx context.Context, c *Client) (*LivestreamList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of livestreams
+func (l *LivestreamList) FirstPage(c *Client) (*LivestreamList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *LivestreamList) FirstPageWithContext(ctx context.Context, c *Client) (*LivestreamList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of livestreams
+func (l *LivestreamList) LastPage(c *Client) (*LivestreamList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *LivestreamList) LastPageWithContext(ctx context.Context, c *Client) (*LivestreamList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *LivestreamList) page(ctx context.Context, c *Client, rel string) (*LivestreamList, error) {
+	page := new(LivestreamList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of players, or ErrNoPage on the last page
+func (l *PlayerList) NextPage(c *Client) (*PlayerList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *PlayerList) NextPageWithContext(ctx context.Context, c *Client) (*PlayerList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of players, or ErrNoPage on the first page
+func (l *PlayerList) PrevPage(c *Client) (*PlayerList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *PlayerList) PrevPageWithContext(ctx context.Context, c *Client) (*PlayerList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of players
+func (l *PlayerList) FirstPage(c *Client) (*PlayerList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *PlayerList) FirstPageWithContext(ctx context.Context, c *Client) (*PlayerList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of players
+func (l *PlayerList) LastPage(c *Client) (*PlayerList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *PlayerList) LastPageWithContext(ctx context.Context, c *Client) (*PlayerList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *PlayerList) page(ctx context.Context, c *Client, rel string) (*PlayerList, error) {
+	page := new(PlayerList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of upload tokens, or ErrNoPage on the last page
+func (l *UploadTokenList) NextPage(c *Client) (*UploadTokenList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *UploadTokenList) NextPageWithContext(ctx context.Context, c *Client) (*UploadTokenList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of upload tokens, or ErrNoPage on the first page
+func (l *UploadTokenList) PrevPage(c *Client) (*UploadTokenList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *UploadTokenList) PrevPageWithContext(ctx context.Context, c *Client) (*UploadTokenList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of upload tokens
+func (l *UploadTokenList) FirstPage(c *Client) (*UploadTokenList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *UploadTokenList) FirstPageWithContext(ctx context.Context, c *Client) (*UploadTokenList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of upload tokens
+func (l *UploadTokenList) LastPage(c *Client) (*UploadTokenList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *UploadTokenList) LastPageWithContext(ctx context.Context, c *Client) (*UploadTokenList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *UploadTokenList) page(ctx context.Context, c *Client, rel string) (*UploadTokenList, error) {
+	page := new(UploadTokenList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of captions, or ErrNoPage on the last page
+func (l *CaptionList) NextPage(c *Client) (*CaptionList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *CaptionList) NextPageWithContext(ctx context.Context, c *Client) (*CaptionList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of captions, or ErrNoPage on the first page
+func (l *CaptionList) PrevPage(c *Client) (*CaptionList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *CaptionList) PrevPageWithContext(ctx context.Context, c *Client) (*CaptionList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of captions
+func (l *CaptionList) FirstPage(c *Client) (*CaptionList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *CaptionList) FirstPageWithContext(ctx context.Context, c *Client) (*CaptionList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of captions
+func (l *CaptionList) LastPage(c *Client) (*CaptionList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *CaptionList) LastPageWithContext(ctx context.Context, c *Client) (*CaptionList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *CaptionList) page(ctx context.Context, c *Client, rel string) (*CaptionList, error) {
+	page := new(CaptionList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of chapters, or ErrNoPage on the last page
+func (l *ChapterList) NextPage(c *Client) (*ChapterList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *ChapterList) NextPageWithContext(ctx context.Context, c *Client) (*ChapterList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of chapters, or ErrNoPage on the first page
+func (l *ChapterList) PrevPage(c *Client) (*ChapterList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *ChapterList) PrevPageWithContext(ctx context.Context, c *Client) (*ChapterList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of chapters
+func (l *ChapterList) FirstPage(c *Client) (*ChapterList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *ChapterList) FirstPageWithContext(ctx context.Context, c *Client) (*ChapterList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of chapters
+func (l *ChapterList) LastPage(c *Client) (*ChapterList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *ChapterList) LastPageWithContext(ctx context.Context, c *Client) (*ChapterList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *ChapterList) page(ctx context.Context, c *Client, rel string) (*ChapterList, error) {
+	page := new(ChapterList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of sessions, or ErrNoPage on the last page
+func (l *StatisticList) NextPage(c *Client) (*StatisticList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *StatisticList) NextPageWithContext(ctx context.Context, c *Client) (*StatisticList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of sessions, or ErrNoPage on the first page
+func (l *StatisticList) PrevPage(c *Client) (*StatisticList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *StatisticList) PrevPageWithContext(ctx context.Context, c *Client) (*StatisticList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of sessions
+func (l *StatisticList) FirstPage(c *Client) (*StatisticList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *StatisticList) FirstPageWithContext(ctx context.Context, c *Client) (*StatisticList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of sessions
+func (l *StatisticList) LastPage(c *Client) (*StatisticList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *StatisticList) LastPageWithContext(ctx context.Context, c *Client) (*StatisticList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *StatisticList) page(ctx context.Context, c *Client, rel string) (*StatisticList, error) {
+	page := new(StatisticList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+//NextPage returns the next page of session events, or ErrNoPage on the last page
+func (l *SessionEventList) NextPage(c *Client) (*SessionEventList, error) {
+	return l.NextPageWithContext(context.Background(), c)
+}
+
+//NextPageWithContext is the same as NextPage with a context controlling the lifetime of the request
+func (l *SessionEventList) NextPageWithContext(ctx context.Context, c *Client) (*SessionEventList, error) {
+	return l.page(ctx, c, "next")
+}
+
+//PrevPage returns the previous page of session events, or ErrNoPage on the first page
+func (l *SessionEventList) PrevPage(c *Client) (*SessionEventList, error) {
+	return l.PrevPageWithContext(context.Background(), c)
+}
+
+//PrevPageWithContext is the same as PrevPage with a context controlling the lifetime of the request
+func (l *SessionEventList) PrevPageWithContext(ctx context.Context, c *Client) (*SessionEventList, error) {
+	return l.page(ctx, c, "previous")
+}
+
+//FirstPage returns the first page of session events
+func (l *SessionEventList) FirstPage(c *Client) (*SessionEventList, error) {
+	return l.FirstPageWithContext(context.Background(), c)
+}
+
+//FirstPageWithContext is the same as FirstPage with a context controlling the lifetime of the request
+func (l *SessionEventList) FirstPageWithContext(ctx context.Context, c *Client) (*SessionEventList, error) {
+	return l.page(ctx, c, "first")
+}
+
+//LastPage returns the last page of session events
+func (l *SessionEventList) LastPage(c *Client) (*SessionEventList, error) {
+	return l.LastPageWithContext(context.Background(), c)
+}
+
+//LastPageWithContext is the same as LastPage with a context controlling the lifetime of the request
+func (l *SessionEventList) LastPageWithContext(ctx context.Context, c *Client) (*SessionEventList, error) {
+	return l.page(ctx, c, "last")
+}
+
+func (l *SessionEventList) page(ctx context.Context, c *Client, rel string) (*SessionEventList, error) {
+	page := new(SessionEventList)
+	err := c.followLink(ctx, l.Pagination, rel, page)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
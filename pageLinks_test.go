@@ -0,0 +1,166 @@
+package apivideosdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestVideoList_Pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.Header.Get("Authorization") != "Bearer fakeToken" {
+			t.Errorf("VideoList.NextPage should authenticate, got Authorization=%s", r.Header.Get("Authorization"))
+		}
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprintf(w, `{"data":[%s], "pagination":{"currentPage":2, "pagesTotal":3, "links":[
+			{"rel":"self", "uri":"/videos?currentPage=2&pageSize=1"},
+			{"rel":"first", "uri":"/videos?currentPage=1&pageSize=1"},
+			{"rel":"previous", "uri":"/videos?currentPage=1&pageSize=1"},
+			{"rel":"next", "uri":"https://ws.api.video/videos?currentPage=3&pageSize=1"},
+			{"rel":"last", "uri":"/videos?currentPage=3&pageSize=1"}
+		]}}`, videoJSONResponses[1])
+	})
+
+	list := &VideoList{Pagination: &Pagination{Links: []Link{
+		{Rel: "next", URI: "/videos?currentPage=2&pageSize=1"},
+	}}}
+
+	page, err := list.NextPage(client)
+	if err != nil {
+		t.Fatalf("VideoList.NextPage error: %v", err)
+	}
+	if !reflect.DeepEqual(page.Data, []Video{videoStructs[1]}) {
+		t.Errorf("VideoList.NextPage\n got=%#v\nwant=%#v", page.Data, []Video{videoStructs[1]})
+	}
+
+	for _, f := range []func(*Client) (*VideoList, error){page.NextPage, page.PrevPage, page.FirstPage, page.LastPage} {
+		_, err = f(client)
+		if err != nil {
+			t.Errorf("VideoList page error: %v", err)
+		}
+	}
+
+	expected := []string{
+		"currentPage=2&pageSize=1",
+		"currentPage=3&pageSize=1",
+		"currentPage=1&pageSize=1",
+		"currentPage=1&pageSize=1",
+		"currentPage=3&pageSize=1",
+	}
+	if !reflect.DeepEqual(queries, expected) {
+		t.Errorf("VideoList pages should follow the links\n got=%#v\nwant=%#v", queries, expected)
+	}
+
+	_, err = list.PrevPage(client)
+	if err != ErrNoPage {
+		t.Errorf("VideoList.PrevPage error got=%v want=%v", err, ErrNoPage)
+	}
+}
+
+func TestLists_Pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pagination := &Pagination{Links: []Link{
+		{Rel: "first", URI: "/items?currentPage=1"},
+		{Rel: "previous", URI: "/items?currentPage=1"},
+		{Rel: "next", URI: "/items?currentPage=3"},
+		{Rel: "last", URI: "/items?currentPage=4"},
+	}}
+
+	tests := []struct {
+		name  string
+		pages func(c *Client) []error
+	}{
+		{"LivestreamList", func(c *Client) []error {
+			l := &LivestreamList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"PlayerList", func(c *Client) []error {
+			l := &PlayerList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"UploadTokenList", func(c *Client) []error {
+			l := &UploadTokenList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"CaptionList", func(c *Client) []error {
+			l := &CaptionList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"ChapterList", func(c *Client) []error {
+			l := &ChapterList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"StatisticList", func(c *Client) []error {
+			l := &StatisticList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+		{"SessionEventList", func(c *Client) []error {
+			l := &SessionEventList{Pagination: pagination}
+			return []error{pageErr(l.NextPage(c)), pageErr(l.PrevPage(c)), pageErr(l.FirstPage(c)), pageErr(l.LastPage(c))}
+		}},
+	}
+
+	var queries []string
+	mux.HandleFunc("/apivideo/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"data":[], "pagination":{"currentPage":2, "pagesTotal":4}}`)
+	})
+
+	// Links are resolved below the path prefix of the base URL
+	baseURL, _ := url.Parse(server.URL + "/apivideo/")
+	c := NewClient("apiKey", WithBaseURL(baseURL))
+
+	for _, test := range tests {
+		queries = nil
+		for _, err := range test.pages(c) {
+			if err != nil {
+				t.Errorf("%s page error: %v", test.name, err)
+			}
+		}
+
+		expected := []string{"currentPage=3", "currentPage=1", "currentPage=1", "currentPage=4"}
+		if !reflect.DeepEqual(queries, expected) {
+			t.Errorf("%s pages should follow the links\n got=%#v\nwant=%#v", test.name, queries, expected)
+		}
+	}
+
+	_, err := (&SessionEventList{}).NextPage(c)
+	if err != ErrNoPage {
+		t.Errorf("SessionEventList.NextPage error got=%v want=%v", err, ErrNoPage)
+	}
+}
+
+// pageErr returns the error of a page call, whatever its list type
+func pageErr(_ interface{}, err error) error {
+	return err
+}
+
+func TestPagination_Link(t *testing.T) {
+	p := &Pagination{Links: []Link{{Rel: "prev", URI: "/videos?currentPage=1"}}}
+
+	uri, ok := p.Link("previous")
+	if !ok || uri != "/videos?currentPage=1" {
+		t.Errorf("Pagination.Link(previous) got=%s, %v", uri, ok)
+	}
+
+	_, ok = p.Link("next")
+	if ok {
+		t.Errorf("Pagination.Link(next) should not be found")
+	}
+
+	var nilPagination *Pagination
+	_, ok = nilPagination.Link("next")
+	if ok {
+		t.Errorf("Pagination.Link on nil pagination should not be found")
+	}
+}
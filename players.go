@@ -1,7 +1,9 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -14,12 +16,23 @@ const playersBasePath = "players"
 // See: https://docs.api.video/5.1/players
 type PlayersServiceI interface {
 	Get(playerID string) (*Player, error)
+	GetWithContext(ctx context.Context, playerID string) (*Player, error)
 	List(opts *PlayerOpts) (*PlayerList, error)
+	ListWithContext(ctx context.Context, opts *PlayerOpts) (*PlayerList, error)
+	Iter(opts *PlayerOpts, iterOpts ...IterOption) *PlayerIterator
+	IterWithContext(ctx context.Context, opts *PlayerOpts, iterOpts ...IterOption) *PlayerIterator
 	Create(createRequest *PlayerRequest) (*Player, error)
+	CreateWithContext(ctx context.Context, createRequest *PlayerRequest) (*Player, error)
 	Update(playerID string, updateRequest *PlayerRequest) (*Player, error)
+	UpdateWithContext(ctx context.Context, playerID string, updateRequest *PlayerRequest) (*Player, error)
 	Delete(playerID string) error
+	DeleteWithContext(ctx context.Context, playerID string) error
 	UploadLogo(playerID string, link string, filepath string) (*Player, error)
+	UploadLogoWithContext(ctx context.Context, playerID string, link string, filepath string) (*Player, error)
+	UploadLogoFromReader(playerID string, link string, name string, r io.Reader) (*Player, error)
+	UploadLogoFromReaderWithContext(ctx context.Context, playerID string, link string, name string, r io.Reader) (*Player, error)
 	DeleteLogo(playerID string) error
+	DeleteLogoWithContext(ctx context.Context, playerID string) error
 }
 
 // PlayersService communicating with the Players
@@ -77,11 +90,12 @@ type PlayerRequest struct {
 	BackgroundTop         string `json:"backgroundTop,omitempty"`
 	BackgroundBottom      string `json:"backgroundBottom,omitempty"`
 	BackgroundText        string `json:"backgroundText,omitempty"`
-	EnableAPI             bool   `json:"enableApi"`
-	EnableControls        bool   `json:"enableControls"`
-	ForceAutoplay         bool   `json:"forceAutoplay"`
-	HideTitle             bool   `json:"hideTitle"`
-	ForceLoop             bool   `json:"forceLoop"`
+	// The options below are only sent when set, use Bool to set them
+	EnableAPI      *bool `json:"enableApi,omitempty"`
+	EnableControls *bool `json:"enableControls,omitempty"`
+	ForceAutoplay  *bool `json:"forceAutoplay,omitempty"`
+	HideTitle      *bool `json:"hideTitle,omitempty"`
+	ForceLoop      *bool `json:"forceLoop,omitempty"`
 }
 
 //PlayerOpts represents a query string to search on players
@@ -98,6 +112,11 @@ type PlayerList struct {
 
 //Get returns a Player by id
 func (s *PlayersService) Get(PlayerID string) (*Player, error) {
+	return s.GetWithContext(context.Background(), PlayerID)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *PlayersService) GetWithContext(ctx context.Context, PlayerID string) (*Player, error) {
 
 	err := checkPlayerID(PlayerID)
 	if err != nil {
@@ -106,7 +125,7 @@ func (s *PlayersService) Get(PlayerID string) (*Player, error) {
 
 	path := fmt.Sprintf("%s/%s", playersBasePath, PlayerID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +142,11 @@ func (s *PlayersService) Get(PlayerID string) (*Player, error) {
 
 //List returns a PlayerList containing all players
 func (s *PlayersService) List(opts *PlayerOpts) (*PlayerList, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *PlayersService) ListWithContext(ctx context.Context, opts *PlayerOpts) (*PlayerList, error) {
 
 	v, err := query.Values(opts)
 
@@ -133,7 +157,7 @@ func (s *PlayersService) List(opts *PlayerOpts) (*PlayerList, error) {
 
 	path := fmt.Sprintf("%s?%s", playersBasePath, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -148,10 +172,41 @@ func (s *PlayersService) List(opts *PlayerOpts) (*PlayerList, error) {
 	return pl, nil
 }
 
+//Iter returns a PlayerIterator over all players, the pages are fetched as needed
+func (s *PlayersService) Iter(opts *PlayerOpts, iterOpts ...IterOption) *PlayerIterator {
+	return s.IterWithContext(context.Background(), opts, iterOpts...)
+}
+
+//IterWithContext is the same as Iter with a context controlling the lifetime of the requests
+func (s *PlayersService) IterWithContext(ctx context.Context, opts *PlayerOpts, iterOpts ...IterOption) *PlayerIterator {
+	o := PlayerOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(PlayerIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.ListWithContext(ctx, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
 //Create a player and returns it
 func (s *PlayersService) Create(createRequest *PlayerRequest) (*Player, error) {
+	return s.CreateWithContext(context.Background(), createRequest)
+}
+
+//CreateWithContext is the same as Create with a context controlling the lifetime of the request
+func (s *PlayersService) CreateWithContext(ctx context.Context, createRequest *PlayerRequest) (*Player, error) {
 
-	req, err := s.client.prepareRequest(http.MethodPost, playersBasePath, createRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, playersBasePath, createRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +223,11 @@ func (s *PlayersService) Create(createRequest *PlayerRequest) (*Player, error) {
 
 //Update a player and returns it
 func (s *PlayersService) Update(playerID string, updateRequest *PlayerRequest) (*Player, error) {
+	return s.UpdateWithContext(context.Background(), playerID, updateRequest)
+}
+
+//UpdateWithContext is the same as Update with a context controlling the lifetime of the request
+func (s *PlayersService) UpdateWithContext(ctx context.Context, playerID string, updateRequest *PlayerRequest) (*Player, error) {
 
 	err := checkPlayerID(playerID)
 	if err != nil {
@@ -176,7 +236,7 @@ func (s *PlayersService) Update(playerID string, updateRequest *PlayerRequest) (
 
 	path := fmt.Sprintf("%s/%s", playersBasePath, playerID)
 
-	req, err := s.client.prepareRequest(http.MethodPatch, path, updateRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, path, updateRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +253,11 @@ func (s *PlayersService) Update(playerID string, updateRequest *PlayerRequest) (
 
 //Delete a player
 func (s *PlayersService) Delete(playerID string) error {
+	return s.DeleteWithContext(context.Background(), playerID)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *PlayersService) DeleteWithContext(ctx context.Context, playerID string) error {
 
 	err := checkPlayerID(playerID)
 	if err != nil {
@@ -201,7 +266,7 @@ func (s *PlayersService) Delete(playerID string) error {
 
 	path := fmt.Sprintf("%s/%s", playersBasePath, playerID)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
@@ -217,6 +282,28 @@ func (s *PlayersService) Delete(playerID string) error {
 
 //UploadLogo upload the logo of a player
 func (s *PlayersService) UploadLogo(playerID string, link string, filePath string) (*Player, error) {
+	return s.UploadLogoWithContext(context.Background(), playerID, link, filePath)
+}
+
+//UploadLogoWithContext is the same as UploadLogo with a context controlling the lifetime of the request
+func (s *PlayersService) UploadLogoWithContext(ctx context.Context, playerID string, link string, filePath string) (*Player, error) {
+
+	file, name, _, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadLogoFromReaderWithContext(ctx, playerID, link, name, file)
+}
+
+//UploadLogoFromReader upload the logo of a player from r, name is the file name sent to the API
+func (s *PlayersService) UploadLogoFromReader(playerID string, link string, name string, r io.Reader) (*Player, error) {
+	return s.UploadLogoFromReaderWithContext(context.Background(), playerID, link, name, r)
+}
+
+//UploadLogoFromReaderWithContext is the same as UploadLogoFromReader with a context controlling the lifetime of the request
+func (s *PlayersService) UploadLogoFromReaderWithContext(ctx context.Context, playerID string, link string, name string, r io.Reader) (*Player, error) {
 
 	err := checkPlayerID(playerID)
 	if err != nil {
@@ -229,7 +316,7 @@ func (s *PlayersService) UploadLogo(playerID string, link string, filePath strin
 		"link": link,
 	}
 
-	req, err := s.client.prepareUploadRequest(path, filePath, fields)
+	req, err := s.client.prepareUploadRequest(ctx, path, name, r, fields)
 
 	if err != nil {
 		return nil, err
@@ -248,6 +335,11 @@ func (s *PlayersService) UploadLogo(playerID string, link string, filePath strin
 
 //DeleteLogo delete a player logo
 func (s *PlayersService) DeleteLogo(playerID string) error {
+	return s.DeleteLogoWithContext(context.Background(), playerID)
+}
+
+//DeleteLogoWithContext is the same as DeleteLogo with a context controlling the lifetime of the request
+func (s *PlayersService) DeleteLogoWithContext(ctx context.Context, playerID string) error {
 
 	err := checkPlayerID(playerID)
 	if err != nil {
@@ -256,7 +348,7 @@ func (s *PlayersService) DeleteLogo(playerID string) error {
 
 	path := fmt.Sprintf("%s/%s/logo", playersBasePath, playerID)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
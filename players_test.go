@@ -163,11 +163,11 @@ var playerRequestStruct = PlayerRequest{
 	BackgroundTop:         "rgba(72, 4, 45, 1)",
 	BackgroundBottom:      "rgba(94, 95, 89, 1)",
 	BackgroundText:        "rgba(255, 255, 255, 0.95)",
-	EnableAPI:             false,
-	EnableControls:        true,
-	ForceAutoplay:         false,
-	HideTitle:             false,
-	ForceLoop:             false,
+	EnableAPI:             Bool(false),
+	EnableControls:        Bool(true),
+	ForceAutoplay:         Bool(false),
+	HideTitle:             Bool(false),
+	ForceLoop:             Bool(false),
 }
 
 func TestPlayers_Get(t *testing.T) {
@@ -0,0 +1,168 @@
+package apivideosdk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is the state of the API quota, read from the headers of a response
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// RetryAfter is the delay before the quota is replenished, when given by the API
+	RetryAfter time.Duration
+	// Time is when the response was received
+	Time time.Time
+}
+
+// Exhausted reports whether no request is left in the window at t
+func (r RateLimit) Exhausted(t time.Time) bool {
+	return r.Remaining <= 0 && r.RetryAfter > 0 && t.Before(r.Time.Add(r.RetryAfter))
+}
+
+// ParseRateLimit reads the rate-limit headers of a response,
+// it returns false when the response has none
+func ParseRateLimit(h http.Header) (RateLimit, bool) {
+	limit, okLimit := headerInt(h, "X-RateLimit-Limit", "RateLimit-Limit")
+	remaining, okRemaining := headerInt(h, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if !okLimit && !okRemaining {
+		return RateLimit{}, false
+	}
+
+	r := RateLimit{Limit: limit, Remaining: remaining, Time: time.Now()}
+	if retryAfter, ok := headerInt(h, "X-RateLimit-Retry-After", "RateLimit-Reset"); ok {
+		r.RetryAfter = time.Duration(retryAfter) * time.Second
+	}
+	return r, true
+}
+
+func headerInt(h http.Header, names ...string) (int, bool) {
+	for _, name := range names {
+		v, err := strconv.Atoi(h.Get(name))
+		if err == nil {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// RateLimit returns the rate limit of the latest response carrying rate-limit headers,
+// it returns false before such a response was received
+func (c *Client) RateLimit() (RateLimit, bool) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	return c.rateLimit, !c.rateLimit.Time.IsZero()
+}
+
+func (c *Client) recordRateLimit(resp *http.Response) {
+	r, ok := ParseRateLimit(resp.Header)
+	if !ok {
+		return
+	}
+
+	c.rateLimitMu.Lock()
+	c.rateLimit = r
+	c.rateLimitMu.Unlock()
+}
+
+// RateLimiter delays requests to keep them under a quota.
+// It is satisfied by *TokenBucket and by golang.org/x/time/rate.Limiter
+type RateLimiter interface {
+	// Wait blocks until a request can be sent or ctx is done
+	Wait(ctx context.Context) error
+}
+
+// WithRateLimiter delays every request sent by the client, authentication included,
+// until l allows it. When the API reports an exhausted quota, requests are also
+// held until it is replenished instead of failing with a 429
+func WithRateLimiter(l RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = l
+	}
+}
+
+// throttle waits until req can be sent according to the client rate limiter
+func (c *Client) throttle(req *http.Request) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	if r, ok := c.RateLimit(); ok && r.Exhausted(time.Now()) {
+		err := sleepContext(req.Context(), time.Until(r.Time.Add(r.RetryAfter)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.rateLimiter.Wait(req.Context())
+}
+
+// TokenBucket is a RateLimiter allowing bursts of requests
+// while keeping their average rate under a limit
+type TokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a TokenBucket allowing rate requests per second on average
+// and up to burst requests at once
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay := b.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		err := sleepContext(ctx, delay)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available,
+// otherwise it returns the delay until the next one
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	if b.rate <= 0 {
+		// No token is ever added, wait for the context
+		return time.Hour
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
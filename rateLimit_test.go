@@ -0,0 +1,193 @@
+package apivideosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	h := http.Header{}
+	_, ok := ParseRateLimit(h)
+	if ok {
+		t.Errorf("ParseRateLimit should not find a rate limit without headers")
+	}
+
+	h.Set("X-RateLimit-Limit", "50")
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Retry-After", "2")
+	r, ok := ParseRateLimit(h)
+	if !ok || r.Limit != 50 || r.Remaining != 0 || r.RetryAfter != 2*time.Second {
+		t.Errorf("ParseRateLimit got=%#v, %v", r, ok)
+	}
+	if !r.Exhausted(r.Time) || r.Exhausted(r.Time.Add(2*time.Second)) {
+		t.Errorf("RateLimit.Exhausted should be true until the quota is replenished")
+	}
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	remaining := 10
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		remaining--
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+		if remaining == 7 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, ok := client.RateLimit()
+	if ok {
+		t.Errorf("Client.RateLimit should be unknown before the first response")
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Account.Get()
+		if err != nil {
+			t.Fatalf("Account.Get error: %v", err)
+		}
+	}
+
+	r, ok := client.RateLimit()
+	if !ok || r.Limit != 10 || r.Remaining != 8 {
+		t.Errorf("Client.RateLimit should hold the latest values, got %#v", r)
+	}
+
+	_, err := client.Account.Get()
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Account.Get error should be rate limited, got %v", err)
+	}
+	if errorResponse.RateLimit == nil || errorResponse.RateLimit.Remaining != 7 {
+		t.Errorf("ErrorResponse.RateLimit got=%#v", errorResponse.RateLimit)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	b := NewTokenBucket(100, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		err := b.Wait(context.Background())
+		if err != nil {
+			t.Fatalf("TokenBucket.Wait error: %v", err)
+		}
+	}
+	// The burst is immediate, the 2 other tokens take 10ms each
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("TokenBucket should limit the rate after the burst, 4 waits took %v", elapsed)
+	}
+
+	empty := NewTokenBucket(0, 1)
+	empty.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := empty.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("TokenBucket.Wait error got=%v want=%v", err, context.DeadlineExceeded)
+	}
+}
+
+type countingLimiter struct {
+	calls int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.calls, 1)
+	return ctx.Err()
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestClient_RateLimiterClosesBody(t *testing.T) {
+	c := NewClient("apiKey", WithRateLimiter(NewTokenBucket(0.0001, 1)))
+	c.rateLimiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	body := &closeRecorder{Reader: strings.NewReader("content")}
+	req, _ := http.NewRequest(http.MethodPost, "https://ws.api.video/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/thumbnail", body)
+
+	_, err := c.roundTrip(req.WithContext(ctx), 1)
+	if err != context.DeadlineExceeded {
+		t.Errorf("roundTrip error got=%v want=%v", err, context.DeadlineExceeded)
+	}
+	if !body.closed {
+		t.Error("roundTrip should close the body of a request the rate limiter rejects")
+	}
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	limiter := &countingLimiter{}
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithRateLimiter(limiter))
+
+	_, err := c.Account.Get()
+	if err != nil {
+		t.Fatalf("Account.Get error: %v", err)
+	}
+
+	// The authentication request is limited too
+	if atomic.LoadInt32(&limiter.calls) != 2 {
+		t.Errorf("RateLimiter.Wait calls got=%d want=%d", limiter.calls, 2)
+	}
+}
+
+func TestClient_RateLimiterExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var last time.Time
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		last = time.Now()
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Retry-After", "1")
+		fmt.Fprint(w, `{}`)
+	})
+
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("apiKey", WithBaseURL(baseURL), WithRateLimiter(NewTokenBucket(1000, 10)))
+
+	_, err := c.Account.Get()
+	if err != nil {
+		t.Fatalf("Account.Get error: %v", err)
+	}
+	first := last
+
+	_, err = c.Account.Get()
+	if err != nil {
+		t.Fatalf("Account.Get error: %v", err)
+	}
+	if last.Sub(first) < 900*time.Millisecond {
+		t.Errorf("Client should wait for the quota to be replenished, waited %v", last.Sub(first))
+	}
+}
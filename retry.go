@@ -0,0 +1,133 @@
+package apivideosdk
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests failing with a transient error
+// (network error, 429 or 5xx) are retried.
+// Only idempotent requests and upload chunks are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value lower than 2 disables retries
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, it is doubled on each attempt
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is a sensible RetryPolicy for batch jobs
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// WithRetryPolicy enables retries of transient failures, by default requests are not retried
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// maxAttempts returns how many times req may be sent
+func (p RetryPolicy) maxAttempts(req *http.Request) int {
+	if p.MaxAttempts < 2 || !isRetryableRequest(req) {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns the delay to wait before the given attempt,
+// honoring the Retry-After header of the previous response if any
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := p.MinBackoff
+	for i := 2; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	// Equal jitter: wait between half and the full backoff
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func isRetryableRequest(req *http.Request) bool {
+	if !canRewindBody(req) {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	// Upload chunks carry their own byte range and can be sent again safely
+	return req.Header.Get("Content-Range") != ""
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= 500 && code != http.StatusNotImplemented)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func canRewindBody(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindBody returns a copy of req with a fresh body, ready to be sent again
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req.Clone(req.Context()), nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
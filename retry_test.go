@@ -0,0 +1,136 @@
+package apivideosdk
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+func TestRetry_TransientFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy)(client)
+
+	var count int
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	_, err := client.Videos.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if err != nil {
+		t.Errorf("Videos.Get error: %v", err)
+	}
+
+	if count != 3 {
+		t.Errorf("Videos.Get should be sent 3 times, got %d", count)
+	}
+}
+
+func TestRetry_Exhausted(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy)(client)
+
+	var count int
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.Videos.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Videos.Get error should be an *ErrorResponse, got %#v", err)
+	}
+
+	if errorResponse.Attempts != 3 || count != 3 {
+		t.Errorf("Videos.Get attempts got=%d (%d calls) want=3", errorResponse.Attempts, count)
+	}
+}
+
+func TestRetry_NonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy)(client)
+
+	var count int
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.Videos.Create(&VideoRequest{Title: "title"})
+	if err == nil {
+		t.Errorf("Videos.Create should fail")
+	}
+
+	if count != 1 {
+		t.Errorf("Videos.Create should not be retried, got %d calls", count)
+	}
+}
+
+func TestRetry_UploadChunk(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy)(client)
+
+	var count int
+	received := map[string]int64{}
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received[r.Header.Get("Content-Range")] = r.ContentLength
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	file := createTempFile("test.video", 4*1024*1024)
+	defer os.Remove(file)
+
+	client.ChunkSize(2 * 1024 * 1024)
+	_, err := client.Videos.Upload("vi4k0jvEUuaTdRAEjQ4Jfagz", file)
+	if err != nil {
+		t.Errorf("Videos.Upload error: %v", err)
+	}
+
+	if count != 3 || len(received) != 2 {
+		t.Errorf("Videos.Upload should retry the failing chunk, got %d calls for %d chunks", count, len(received))
+	}
+	for ranges, length := range received {
+		if length <= 0 {
+			t.Errorf("Videos.Upload chunk %s sent with an empty body", ranges)
+		}
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, max := range map[int]time.Duration{2: 100 * time.Millisecond, 3: 200 * time.Millisecond, 10: time.Second} {
+		d := p.backoff(attempt, nil)
+		if d < max/2 || d > max {
+			t.Errorf("RetryPolicy.backoff(%d) got=%v want between %v and %v", attempt, d, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if d := p.backoff(2, resp); d != 7*time.Second {
+		t.Errorf("RetryPolicy.backoff should honor Retry-After, got %v", d)
+	}
+}
@@ -1,9 +1,11 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -15,8 +17,17 @@ const statisticsBasePath = "analytics"
 // See: https://docs.api.video/5.1/players
 type StatisticsServiceI interface {
 	GetVideoSessions(videoID string, opts *SessionVideoOpts) (*StatisticList, error)
+	GetVideoSessionsWithContext(ctx context.Context, videoID string, opts *SessionVideoOpts) (*StatisticList, error)
+	IterVideoSessions(videoID string, opts *SessionVideoOpts, iterOpts ...IterOption) *StatisticIterator
+	IterVideoSessionsWithContext(ctx context.Context, videoID string, opts *SessionVideoOpts, iterOpts ...IterOption) *StatisticIterator
 	GetLivestreamSessions(LivestreamID string, opts *SessionLivestreamOpts) (*StatisticList, error)
+	GetLivestreamSessionsWithContext(ctx context.Context, LivestreamID string, opts *SessionLivestreamOpts) (*StatisticList, error)
+	IterLivestreamSessions(livestreamID string, opts *SessionLivestreamOpts, iterOpts ...IterOption) *StatisticIterator
+	IterLivestreamSessionsWithContext(ctx context.Context, livestreamID string, opts *SessionLivestreamOpts, iterOpts ...IterOption) *StatisticIterator
 	GetSessionEvents(SessionID string, opts *SessionEventOpts) (*SessionEventList, error)
+	GetSessionEventsWithContext(ctx context.Context, SessionID string, opts *SessionEventOpts) (*SessionEventList, error)
+	IterSessionEvents(sessionID string, opts *SessionEventOpts, iterOpts ...IterOption) *SessionEventIterator
+	IterSessionEventsWithContext(ctx context.Context, sessionID string, opts *SessionEventOpts, iterOpts ...IterOption) *SessionEventIterator
 }
 
 // StatisticsService communicating with the Statistics
@@ -44,11 +55,35 @@ type Statistic struct {
 //Session represents a Session
 type Session struct {
 	SessionID string            `json:"sessionId,omitempty"`
-	LoadedAt  string            `json:"loadedAt,omitempty"`
-	EndedAt   string            `json:"endedAt,omitempty"`
+	LoadedAt  Timestamp         `json:"loadedAt,omitempty"`
+	EndedAt   Timestamp         `json:"endedAt,omitempty"`
 	Metadata  map[string]string `json:"metadata,omitempty"`
 }
 
+//Ended reports whether the session has ended
+func (s *Session) Ended() bool {
+	return !s.LoadedAt.IsZero() && !s.EndedAt.IsZero()
+}
+
+//Duration returns the time between the load and the end of the session, 0 if it has not ended
+func (s *Session) Duration() time.Duration {
+	if !s.Ended() {
+		return 0
+	}
+	return s.EndedAt.Sub(s.LoadedAt.Time)
+}
+
+//DurationAt returns the duration of the session at now, which is its full duration once it has ended
+func (s *Session) DurationAt(now time.Time) time.Duration {
+	if s.LoadedAt.IsZero() {
+		return 0
+	}
+	if s.Ended() {
+		return s.Duration()
+	}
+	return now.Sub(s.LoadedAt.Time)
+}
+
 //Location represents a Location
 type Location struct {
 	Country string `json:"country,omitempty"`
@@ -86,11 +121,11 @@ type SessClient struct {
 
 //SessionEvent represents a SessionEvent
 type SessionEvent struct {
-	Type      string `json:"type,omitempty"`
-	EmittedAt string `json:"emittedAt,omitempty"`
-	At        int    `json:"at,omitempty"`
-	From      int    `json:"from,omitempty"`
-	To        int    `json:"to,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	EmittedAt Timestamp `json:"emittedAt,omitempty"`
+	At        int       `json:"at,omitempty"`
+	From      int       `json:"from,omitempty"`
+	To        int       `json:"to,omitempty"`
 }
 
 //SessionEventList represents a SessionEventList
@@ -103,7 +138,7 @@ type SessionEventList struct {
 type SessionVideoOpts struct {
 	CurrentPage int               `url:"currentPage,omitempty"`
 	PageSize    int               `url:"pageSize,omitempty"`
-	Period      string            `url:"period,omitempty"`
+	Period      Period            `url:"period,omitempty"`
 	Metadata    map[string]string `url:"-"`
 }
 
@@ -111,7 +146,7 @@ type SessionVideoOpts struct {
 type SessionLivestreamOpts struct {
 	CurrentPage int    `url:"currentPage,omitempty"`
 	PageSize    int    `url:"pageSize,omitempty"`
-	Period      string `url:"period,omitempty"`
+	Period      Period `url:"period,omitempty"`
 }
 
 //SessionEventOpts represents a query string to search on sessions
@@ -122,12 +157,24 @@ type SessionEventOpts struct {
 
 //GetVideoSessions returns a StatisticList containing all sessions for a video
 func (s *StatisticsService) GetVideoSessions(videoID string, opts *SessionVideoOpts) (*StatisticList, error) {
+	return s.GetVideoSessionsWithContext(context.Background(), videoID, opts)
+}
+
+//GetVideoSessionsWithContext is the same as GetVideoSessions with a context controlling the lifetime of the request
+func (s *StatisticsService) GetVideoSessionsWithContext(ctx context.Context, videoID string, opts *SessionVideoOpts) (*StatisticList, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
 		return nil, err
 	}
 
+	if opts != nil {
+		err = checkPeriod(opts.Period)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	v, err := query.Values(opts)
 
 	if err != nil {
@@ -145,7 +192,7 @@ func (s *StatisticsService) GetVideoSessions(videoID string, opts *SessionVideoO
 
 	path := fmt.Sprintf("%s/videos/%s?%s", statisticsBasePath, videoID, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,14 +207,52 @@ func (s *StatisticsService) GetVideoSessions(videoID string, opts *SessionVideoO
 	return sl, nil
 }
 
+//IterVideoSessions returns a StatisticIterator over all sessions for a video, the pages are fetched as needed
+func (s *StatisticsService) IterVideoSessions(videoID string, opts *SessionVideoOpts, iterOpts ...IterOption) *StatisticIterator {
+	return s.IterVideoSessionsWithContext(context.Background(), videoID, opts, iterOpts...)
+}
+
+//IterVideoSessionsWithContext is the same as IterVideoSessions with a context controlling the lifetime of the requests
+func (s *StatisticsService) IterVideoSessionsWithContext(ctx context.Context, videoID string, opts *SessionVideoOpts, iterOpts ...IterOption) *StatisticIterator {
+	o := SessionVideoOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(StatisticIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.GetVideoSessionsWithContext(ctx, videoID, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
 //GetLivestreamSessions returns a StatisticList containing all sessions for a video
 func (s *StatisticsService) GetLivestreamSessions(livestreamID string, opts *SessionLivestreamOpts) (*StatisticList, error) {
+	return s.GetLivestreamSessionsWithContext(context.Background(), livestreamID, opts)
+}
+
+//GetLivestreamSessionsWithContext is the same as GetLivestreamSessions with a context controlling the lifetime of the request
+func (s *StatisticsService) GetLivestreamSessionsWithContext(ctx context.Context, livestreamID string, opts *SessionLivestreamOpts) (*StatisticList, error) {
 
 	err := checkLivestreamID(livestreamID)
 	if err != nil {
 		return nil, err
 	}
 
+	if opts != nil {
+		err = checkPeriod(opts.Period)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	v, err := query.Values(opts)
 
 	if err != nil {
@@ -177,7 +262,7 @@ func (s *StatisticsService) GetLivestreamSessions(livestreamID string, opts *Ses
 
 	path := fmt.Sprintf("%s/live-streams/%s?%s", statisticsBasePath, livestreamID, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -192,8 +277,39 @@ func (s *StatisticsService) GetLivestreamSessions(livestreamID string, opts *Ses
 	return sl, nil
 }
 
+//IterLivestreamSessions returns a StatisticIterator over all sessions for a livestream, the pages are fetched as needed
+func (s *StatisticsService) IterLivestreamSessions(livestreamID string, opts *SessionLivestreamOpts, iterOpts ...IterOption) *StatisticIterator {
+	return s.IterLivestreamSessionsWithContext(context.Background(), livestreamID, opts, iterOpts...)
+}
+
+//IterLivestreamSessionsWithContext is the same as IterLivestreamSessions with a context controlling the lifetime of the requests
+func (s *StatisticsService) IterLivestreamSessionsWithContext(ctx context.Context, livestreamID string, opts *SessionLivestreamOpts, iterOpts ...IterOption) *StatisticIterator {
+	o := SessionLivestreamOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(StatisticIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.GetLivestreamSessionsWithContext(ctx, livestreamID, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
 //GetSessionEvents returns a StatisticList containing all stats for one session
 func (s *StatisticsService) GetSessionEvents(sessionID string, opts *SessionEventOpts) (*SessionEventList, error) {
+	return s.GetSessionEventsWithContext(context.Background(), sessionID, opts)
+}
+
+//GetSessionEventsWithContext is the same as GetSessionEvents with a context controlling the lifetime of the request
+func (s *StatisticsService) GetSessionEventsWithContext(ctx context.Context, sessionID string, opts *SessionEventOpts) (*SessionEventList, error) {
 
 	err := checkSessionID(sessionID)
 	if err != nil {
@@ -209,7 +325,7 @@ func (s *StatisticsService) GetSessionEvents(sessionID string, opts *SessionEven
 
 	path := fmt.Sprintf("%s/sessions/%s/events?%s", statisticsBasePath, sessionID, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -223,3 +339,29 @@ func (s *StatisticsService) GetSessionEvents(sessionID string, opts *SessionEven
 
 	return sel, nil
 }
+
+//IterSessionEvents returns a SessionEventIterator over all events of a session, the pages are fetched as needed
+func (s *StatisticsService) IterSessionEvents(sessionID string, opts *SessionEventOpts, iterOpts ...IterOption) *SessionEventIterator {
+	return s.IterSessionEventsWithContext(context.Background(), sessionID, opts, iterOpts...)
+}
+
+//IterSessionEventsWithContext is the same as IterSessionEvents with a context controlling the lifetime of the requests
+func (s *StatisticsService) IterSessionEventsWithContext(ctx context.Context, sessionID string, opts *SessionEventOpts, iterOpts ...IterOption) *SessionEventIterator {
+	o := SessionEventOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(SessionEventIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.GetSessionEventsWithContext(ctx, sessionID, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
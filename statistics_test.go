@@ -80,8 +80,8 @@ var statsStructs = []Statistic{
 	Statistic{
 		Session: &Session{
 			SessionID: "psEmFwGQUAXR2lFHj5nDOpy",
-			LoadedAt:  "2019-06-24T11:45:01.109+00",
-			EndedAt:   "2019-06-24T11:49:19.243+00",
+			LoadedAt:  mustTimestamp("2019-06-24T11:45:01.109+00"),
+			EndedAt:   mustTimestamp("2019-06-24T11:49:19.243+00"),
 		},
 		Location: &Location{
 			Country: "France",
@@ -112,8 +112,8 @@ var statsStructs = []Statistic{
 	Statistic{
 		Session: &Session{
 			SessionID: "psLQOSDqsdoqsjdoLQSD65o",
-			LoadedAt:  "2019-06-25T11:45:01.109+00",
-			EndedAt:   "2019-06-25T11:49:19.243+00",
+			LoadedAt:  mustTimestamp("2019-06-25T11:45:01.109+00"),
+			EndedAt:   mustTimestamp("2019-06-25T11:49:19.243+00"),
 		},
 		Location: &Location{
 			Country: "France",
@@ -166,19 +166,19 @@ var sessEventJSONResponses = []string{
 var sessEventStructs = []SessionEvent{
 	SessionEvent{
 		Type:      "player_session_vod.loaded",
-		EmittedAt: "2019-01-01 03:11:35.973+01",
+		EmittedAt: mustTimestamp("2019-01-01 03:11:35.973+01"),
 		At:        0,
 		From:      10,
 		To:        15,
 	},
 	SessionEvent{
 		Type:      "player_session_vod.played",
-		EmittedAt: "2019-01-01 03:11:36.232+01",
+		EmittedAt: mustTimestamp("2019-01-01 03:11:36.232+01"),
 		At:        0,
 	},
 	SessionEvent{
 		Type:      "player_session_vod.paused",
-		EmittedAt: "2019-01-01 03:11:38.837+01",
+		EmittedAt: mustTimestamp("2019-01-01 03:11:38.837+01"),
 		At:        2,
 	},
 }
@@ -0,0 +1,77 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the formats of the timestamps returned by the API,
+// tried in order once a space between date and time is replaced by a 'T'
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05Z07",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp is a time returned by the API.
+// It reads the ISO-8601 variants used by the API, like "2019-07-14T23:36:18.598Z"
+// or "2019-01-01 03:11:35.973+01", and is written back as RFC 3339.
+// A missing timestamp is the zero time, written as null
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp parses a timestamp in one of the formats returned by the API
+func ParseTimestamp(value string) (Timestamp, error) {
+	if len(value) > 10 && value[10] == ' ' {
+		value = value[:10] + "T" + value[11:]
+	}
+
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return Timestamp{t}, nil
+		}
+	}
+
+	return Timestamp{}, fmt.Errorf("Timestamp value %q is invalid", value)
+}
+
+// MarshalJSON writes the timestamp as RFC 3339, or null if it is zero
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.Format(time.RFC3339Nano) + `"`), nil
+}
+
+// UnmarshalJSON reads a timestamp in one of the formats returned by the API,
+// null and empty strings are read as the zero time
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	value := string(data)
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return fmt.Errorf("Timestamp value %s is not a string", value)
+	}
+	value = strings.TrimSpace(value[1 : len(value)-1])
+
+	if value == "" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	ts, err := ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	*t = ts
+	return nil
+}
@@ -0,0 +1,96 @@
+package apivideosdk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func mustTimestamp(value string) Timestamp {
+	t, err := ParseTimestamp(value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2019-07-14T23:36:18.598Z", time.Date(2019, 7, 14, 23, 36, 18, 598000000, time.UTC)},
+		{"2019-12-16T08:25:51+00:00", time.Date(2019, 12, 16, 8, 25, 51, 0, time.UTC)},
+		{"2019-06-24T11:45:01.109+00", time.Date(2019, 6, 24, 11, 45, 1, 109000000, time.UTC)},
+		{"2019-01-01 03:11:35.973+01", time.Date(2019, 1, 1, 2, 11, 35, 973000000, time.UTC)},
+		{"2019-01-01T03:11:35+0100", time.Date(2019, 1, 1, 2, 11, 35, 0, time.UTC)},
+		{"2019-01-01 03:11:35", time.Date(2019, 1, 1, 3, 11, 35, 0, time.UTC)},
+		{"2019-01-01", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := ParseTimestamp(test.value)
+		if err != nil {
+			t.Errorf("ParseTimestamp(%q) error: %v", test.value, err)
+			continue
+		}
+		if !got.Equal(test.expected) {
+			t.Errorf("ParseTimestamp(%q) got=%v want=%v", test.value, got, test.expected)
+		}
+	}
+
+	_, err := ParseTimestamp("yesterday")
+	if err == nil {
+		t.Errorf("ParseTimestamp should reject an invalid timestamp")
+	}
+}
+
+func TestTimestamp_JSON(t *testing.T) {
+	var v struct {
+		At    Timestamp `json:"at"`
+		Empty Timestamp `json:"empty"`
+		Null  Timestamp `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"at": "2019-01-01 03:11:35.973+01", "empty": "", "null": null}`), &v)
+	if err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if !v.Empty.IsZero() || !v.Null.IsZero() {
+		t.Errorf("json.Unmarshal should read missing timestamps as zero, got %v and %v", v.Empty, v.Null)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	expected := `{"at":"2019-01-01T03:11:35.973+01:00","empty":null,"null":null}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal got=%s want=%s", data, expected)
+	}
+
+	var back struct {
+		At Timestamp `json:"at"`
+	}
+	err = json.Unmarshal(data, &back)
+	if err != nil || !back.At.Equal(v.At.Time) {
+		t.Errorf("Timestamp should round-trip, got %v want %v (%v)", back.At, v.At, err)
+	}
+}
+
+func TestSession_Duration(t *testing.T) {
+	session := &Session{
+		LoadedAt: mustTimestamp("2019-06-24T11:45:01.109+00"),
+		EndedAt:  mustTimestamp("2019-06-24T11:49:19.243+00"),
+	}
+	if !session.Ended() || session.Duration() != 4*time.Minute+18134*time.Millisecond {
+		t.Errorf("Session.Duration got=%v", session.Duration())
+	}
+
+	ongoing := &Session{LoadedAt: session.LoadedAt}
+	if ongoing.Ended() || ongoing.Duration() != 0 {
+		t.Errorf("Session.Duration of an ongoing session got=%v want=0", ongoing.Duration())
+	}
+	if d := ongoing.DurationAt(session.LoadedAt.Add(time.Minute)); d != time.Minute {
+		t.Errorf("Session.DurationAt got=%v want=%v", d, time.Minute)
+	}
+}
@@ -0,0 +1,176 @@
+package apivideosdk
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// TokenSource provides the access tokens used to authenticate requests.
+// By default a Client exchanges its API key for tokens
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// contextTokenSource is implemented by token sources able to
+// bind the token retrieval to the request context
+type contextTokenSource interface {
+	tokenContext(ctx context.Context) (*Token, error)
+}
+
+// tokenInvalidator is implemented by token sources caching tokens,
+// it drops a token rejected by the API
+type tokenInvalidator interface {
+	invalidate(accessToken string)
+}
+
+// WithTokenSource authenticates requests with tokens from ts instead of the API key
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
+
+// WithTokenCache persists the tokens used by the client in the file at path,
+// so they can be reused between runs of a short-lived process
+func WithTokenCache(path string) ClientOption {
+	return func(c *Client) {
+		c.tokenCachePath = path
+	}
+}
+
+// APIKeyTokenSource returns the TokenSource exchanging the client API key for tokens
+func (c *Client) APIKeyTokenSource() TokenSource {
+	return &apiKeyTokenSource{client: c}
+}
+
+type apiKeyTokenSource struct {
+	client *Client
+}
+
+func (s *apiKeyTokenSource) Token() (*Token, error) {
+	return s.client.token(context.Background())
+}
+
+func (s *apiKeyTokenSource) tokenContext(ctx context.Context) (*Token, error) {
+	return s.client.token(ctx)
+}
+
+func (s *apiKeyTokenSource) invalidate(accessToken string) {
+	s.client.invalidateToken(accessToken)
+}
+
+// StaticTokenSource returns a TokenSource always returning token,
+// for access tokens managed outside of the SDK
+func StaticTokenSource(token *Token) TokenSource {
+	return staticTokenSource{token: token}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token() (*Token, error) {
+	return s.token, nil
+}
+
+// FileCacheTokenSource returns a TokenSource caching the tokens of src in the file at path.
+// A token found in the file is reused until it expires, then src is asked for a new one
+func FileCacheTokenSource(path string, src TokenSource) TokenSource {
+	return &fileCacheTokenSource{path: path, src: src}
+}
+
+type fileCacheTokenSource struct {
+	path string
+	src  TokenSource
+
+	mu     sync.Mutex
+	token  *Token
+	loaded bool
+}
+
+func (s *fileCacheTokenSource) Token() (*Token, error) {
+	return s.tokenContext(context.Background())
+}
+
+func (s *fileCacheTokenSource) tokenContext(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded {
+		s.loaded = true
+		s.token = s.load()
+	}
+
+	if isTokenValid(s.token) {
+		return s.token, nil
+	}
+
+	token, err := sourceToken(ctx, s.src)
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = token
+	err = s.save(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (s *fileCacheTokenSource) invalidate(accessToken string) {
+	s.mu.Lock()
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+		os.Remove(s.path)
+	}
+	s.mu.Unlock()
+
+	if i, ok := s.src.(tokenInvalidator); ok {
+		i.invalidate(accessToken)
+	}
+}
+
+func (s *fileCacheTokenSource) load() *Token {
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil
+	}
+
+	token := new(Token)
+	if json.Unmarshal(data, token) != nil {
+		return nil
+	}
+
+	return token
+}
+
+func (s *fileCacheTokenSource) save(token *Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, data, 0600)
+}
+
+// isTokenValid reports whether token can still be used for a while,
+// a token without expire time never expires
+func isTokenValid(token *Token) bool {
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+	return token.ExpireTime.IsZero() || time.Now().Add(tokenRefreshMargin).Before(token.ExpireTime)
+}
+
+func sourceToken(ctx context.Context, ts TokenSource) (*Token, error) {
+	if cts, ok := ts.(contextTokenSource); ok {
+		return cts.tokenContext(ctx)
+	}
+	return ts.Token()
+}
@@ -0,0 +1,103 @@
+package apivideosdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type countingTokenSource struct {
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*Token, error) {
+	s.calls++
+	return &Token{
+		AccessToken: fmt.Sprintf("token%d", s.calls),
+		ExpireTime:  time.Now().Add(time.Hour),
+	}, nil
+}
+
+func TestTokenSource_Static(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer staticToken" {
+			t.Errorf("Client Authorization got=%s want=%s", r.Header.Get("Authorization"), "Bearer staticToken")
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	baseURL, _ := url.Parse(server.URL)
+	c := NewClient("", WithBaseURL(baseURL), WithTokenSource(StaticTokenSource(&Token{AccessToken: "staticToken"})))
+
+	_, err := c.Account.Get()
+	if err != nil {
+		t.Errorf("Account.Get error: %v", err)
+	}
+}
+
+func TestTokenSource_FileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apivideo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token.json")
+
+	src := &countingTokenSource{}
+
+	token, err := FileCacheTokenSource(path, src).Token()
+	if err != nil {
+		t.Errorf("FileCacheTokenSource.Token error: %v", err)
+	}
+	if token.AccessToken != "token1" {
+		t.Errorf("FileCacheTokenSource.Token got=%s want=%s", token.AccessToken, "token1")
+	}
+
+	// A new source, as in a new run of the process, reuses the persisted token
+	token, err = FileCacheTokenSource(path, src).Token()
+	if err != nil {
+		t.Errorf("FileCacheTokenSource.Token error: %v", err)
+	}
+	if token.AccessToken != "token1" || src.calls != 1 {
+		t.Errorf("FileCacheTokenSource.Token should reuse the cached token, got %s after %d calls", token.AccessToken, src.calls)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("FileCacheTokenSource should persist the token: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("FileCacheTokenSource file mode got=%v want=%v", info.Mode().Perm(), os.FileMode(0600))
+	}
+}
+
+func TestTokenSource_FileCacheExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apivideo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token.json")
+
+	err = ioutil.WriteFile(path, []byte(`{"access_token": "expired", "ExpireTime": "2019-07-14T23:36:18Z"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := &countingTokenSource{}
+	token, err := FileCacheTokenSource(path, src).Token()
+	if err != nil {
+		t.Errorf("FileCacheTokenSource.Token error: %v", err)
+	}
+	if token.AccessToken != "token1" {
+		t.Errorf("FileCacheTokenSource.Token should replace an expired token, got %s", token.AccessToken)
+	}
+}
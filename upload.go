@@ -0,0 +1,676 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// byteRange is the range of bytes [start, end) of an upload
+type byteRange struct {
+	start int64
+	end   int64
+}
+
+func (r byteRange) length() int64 {
+	return r.end - r.start
+}
+
+// contentRange returns the Content-Range header value of chunk,
+// the last byte position of the header is inclusive
+func contentRange(chunk byteRange, size int64) (string, error) {
+	if chunk.start < 0 || chunk.end <= chunk.start || chunk.end > size {
+		return "", fmt.Errorf("invalid upload chunk %d-%d for a content of %d bytes", chunk.start, chunk.end, size)
+	}
+	return fmt.Sprintf("bytes %d-%d/%d", chunk.start, chunk.end-1, size), nil
+}
+
+// errShortContent is returned when the upload content holds less bytes than its declared size
+func errShortContent(size int64) error {
+	return fmt.Errorf("upload content is shorter than its declared size of %d bytes", size)
+}
+
+// chunkedUpload describes the content sent by Client.uploadChunks
+type chunkedUpload struct {
+	urlStr string
+	name   string
+	r      io.Reader
+	size   int64
+	// missing lists the ranges to send in ascending order, the whole content when nil
+	missing []byteRange
+	// acknowledged is called after every chunk accepted by the API
+	acknowledged func(chunk byteRange) error
+	options      *uploadOptions
+	// anonymous chunks are sent without authentication
+	anonymous bool
+	// delegated chunks after the first one carry the id of the video
+	// created by the first chunk, unless videoID is already known
+	delegated bool
+	videoID   string
+}
+
+// UploadProgress reports the progress of a video upload
+type UploadProgress struct {
+	// BytesSent is the number of bytes of the content written to the connection,
+	// including the bytes received by the API before a resumed upload
+	BytesSent int64
+	// TotalBytes is the size of the content
+	TotalBytes int64
+	// ChunkIndex is the index of the chunk being sent, starting from 0
+	ChunkIndex int
+	// ChunksTotal is the number of chunks sent by this upload
+	ChunksTotal int
+	// Throughput is the average number of bytes sent per second since the upload started
+	Throughput float64
+}
+
+// UploadOption configures a video upload
+type UploadOption func(*uploadOptions)
+
+type uploadOptions struct {
+	progress    func(UploadProgress)
+	concurrency int
+}
+
+func newUploadOptions(opts []UploadOption) *uploadOptions {
+	o := &uploadOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithProgress calls fn as the content of the upload is written to the connection.
+// fn is called often and must return quickly
+func WithProgress(fn func(UploadProgress)) UploadOption {
+	return func(o *uploadOptions) {
+		o.progress = fn
+	}
+}
+
+// WithConcurrency sends up to n chunks of a video upload in parallel, the last
+// chunk being sent once all the others were accepted. Each chunk is retried
+// according to the client RetryPolicy. When the content is not an io.ReaderAt,
+// up to n chunks are held in memory
+func WithConcurrency(n int) UploadOption {
+	return func(o *uploadOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithProgressChannel sends the progress of the upload to ch.
+// Updates are dropped while ch is not ready to receive, so the upload is never slowed down
+func WithProgressChannel(ch chan<- UploadProgress) UploadOption {
+	return WithProgress(func(p UploadProgress) {
+		select {
+		case ch <- p:
+		default:
+		}
+	})
+}
+
+// chunks splits the ranges to send in chunks of at most chunkSize bytes
+func (u *chunkedUpload) chunks(chunkSize int64) []byteRange {
+	missing := u.missing
+	if missing == nil {
+		missing = []byteRange{{0, u.size}}
+	}
+
+	// An empty content is still sent once
+	if u.size == 0 {
+		return []byteRange{{0, 0}}
+	}
+
+	chunks := []byteRange{}
+	for _, rg := range missing {
+		for start := rg.start; start < rg.end; start += chunkSize {
+			end := start + chunkSize
+			if end > rg.end {
+				end = rg.end
+			}
+			chunks = append(chunks, byteRange{start, end})
+		}
+	}
+	return chunks
+}
+
+// uploadChunks sends the content of u to the API in chunks.
+// When the content is bigger than the chunk size or partially sent, every chunk carries its Content-Range.
+// Chunks are sent one at a time unless the upload concurrency is more than 1,
+// the last chunk is always sent once all the others were accepted.
+// At most one chunk per concurrent request is held in memory, none when the content is an io.ReaderAt.
+// v is filled with the response to the last chunk
+func (c *Client) uploadChunks(ctx context.Context, u *chunkedUpload, v interface{}) (err error) {
+
+	ctx, end := c.startUpload(ctx, u, v)
+	defer func() { end(err) }()
+
+	envelope, err := newMultipartEnvelope(u.name, nil)
+	if err != nil {
+		return err
+	}
+
+	if u.options == nil {
+		u.options = newUploadOptions(nil)
+	}
+
+	chunkSize := u.size
+	if c.chunkSize > 0 && c.chunkSize < u.size {
+		chunkSize = c.chunkSize
+	}
+
+	source, err := newChunkSource(u.r, u.size)
+	if err != nil {
+		return err
+	}
+
+	chunks := u.chunks(chunkSize)
+
+	sender := &chunkSender{
+		client:   c,
+		upload:   u,
+		envelope: envelope,
+		source:   source,
+		chunks:   chunks,
+		// A partial upload always needs ranges, even within a single chunk
+		chunked: chunkSize < u.size || (u.missing != nil && u.size > 0),
+	}
+
+	if u.options.progress != nil {
+		sender.progress = newProgressTracker(u, chunks)
+	}
+
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	// The API finalizes the video when it receives the last chunk
+	last := len(chunks) - 1
+	first := 0
+
+	if u.delegated && u.videoID == "" && last > 0 {
+		// The first chunk of a delegated upload creates the video
+		// and must be accepted before any other chunk is sent
+		content, release, err := source.read(chunks[0])
+		if err != nil {
+			return err
+		}
+
+		err = sender.send(ctx, 0, content, new(Video))
+		release()
+		if err != nil {
+			return err
+		}
+
+		first = 1
+	}
+
+	if u.delegated && u.videoID != "" {
+		sender.envelope, err = newMultipartEnvelope(u.name, map[string]string{"videoId": u.videoID})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = sender.sendAll(ctx, first, last, u.options.concurrency)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	content, release, err := source.read(chunks[last])
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return sender.send(ctx, last, content, v)
+}
+
+// chunkSender sends the chunks of an upload
+type chunkSender struct {
+	client   *Client
+	upload   *chunkedUpload
+	envelope *multipartEnvelope
+	source   *chunkSource
+	chunks   []byteRange
+	chunked  bool
+	progress *progressTracker
+
+	mu      sync.Mutex
+	done    map[int]bool
+	nextAck int
+}
+
+// sendAll sends the chunks from index first to last (excluded), up to concurrency at a time.
+// Once a chunk fails no new chunk is started, and the errors of all
+// the failed chunks are returned
+func (s *chunkSender) sendAll(ctx context.Context, first int, last int, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := []*ChunkError{}
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	}
+	fail := func(i int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, &ChunkError{Index: i, Start: s.chunks[i].start, End: s.chunks[i].end, Err: err})
+	}
+
+	sem := make(chan struct{}, concurrency)
+	for i := first; i < last; i++ {
+		sem <- struct{}{}
+		if failed() || ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		// Chunks are read in order, as stream sources can only be read forward
+		content, release, err := s.source.read(s.chunks[i])
+		if err != nil {
+			<-sem
+			fail(i, err)
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer release()
+
+			err := s.send(ctx, i, content, nil)
+			if err != nil {
+				fail(i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return ctx.Err()
+	}
+	if len(errs) == 1 {
+		return errs[0].Err
+	}
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+	return &UploadError{Errors: errs}
+}
+
+// send sends the chunk at index i read from the source, filling v with the response
+func (s *chunkSender) send(ctx context.Context, i int, content func() io.Reader, v interface{}) error {
+	chunk := s.chunks[i]
+
+	if s.progress != nil {
+		content = s.progress.track(i, content)
+	}
+
+	attrs := []Attribute{{AttrChunkIndex, i}}
+	if s.upload.videoID != "" {
+		attrs = append(attrs, Attribute{AttrResourceID, s.upload.videoID})
+	}
+	ctx = withAttributes(ctx, attrs...)
+
+	var req *http.Request
+	var err error
+	if s.upload.anonymous {
+		req, err = s.client.newRequest(ctx, http.MethodPost, s.upload.urlStr, s.envelope.body(content()))
+	} else {
+		req, err = s.client.prepareRequest(ctx, http.MethodPost, s.upload.urlStr, s.envelope.body(content()))
+	}
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = s.envelope.size(chunk.length())
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(s.envelope.body(content())), nil
+	}
+	req.Header.Set("Content-Type", s.envelope.contentType)
+
+	if s.chunked {
+		ranges, err := contentRange(chunk, s.upload.size)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Range", ranges)
+	}
+
+	_, err = s.client.do(req, v)
+	if err != nil {
+		return err
+	}
+
+	s.client.recordUploaded(ctx, chunk.length())
+
+	if video, ok := v.(*Video); ok && s.upload.delegated && s.upload.videoID == "" {
+		// Only the first chunk of a delegated upload is sent without video id
+		s.upload.videoID = video.VideoID
+	}
+
+	return s.acknowledge(i)
+}
+
+// acknowledge records the chunk at index i as accepted by the API.
+// The upload is notified of accepted chunks in order, so chunks accepted
+// ahead of a chunk still in flight are notified once it is accepted too
+func (s *chunkSender) acknowledge(i int) error {
+	if s.upload.acknowledged == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done == nil {
+		s.done = map[int]bool{}
+	}
+	s.done[i] = true
+
+	for s.done[s.nextAck] {
+		delete(s.done, s.nextAck)
+		err := s.upload.acknowledged(s.chunks[s.nextAck])
+		s.nextAck++
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkError is the error of one chunk of an upload
+type ChunkError struct {
+	Index int
+	// Start and End are the range of bytes [Start, End) of the chunk
+	Start int64
+	End   int64
+	Err   error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk %d (bytes %d-%d): %v", e.Index, e.Start, e.End-1, e.Err)
+}
+
+// Unwrap returns the error of the chunk
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// UploadError aggregates the errors of the chunks which failed during a
+// concurrent upload, ordered by chunk index
+type UploadError struct {
+	Errors []*ChunkError
+}
+
+func (e *UploadError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d chunks failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the error of the first chunk which failed
+func (e *UploadError) Unwrap() error {
+	return e.Errors[0]
+}
+
+// progressTracker reports the progress of an upload from the bytes
+// read from the chunks by the transport
+type progressTracker struct {
+	fn          func(UploadProgress)
+	total       int64
+	chunksTotal int
+	start       time.Time
+
+	mu        sync.Mutex
+	initial   int64
+	sent      int64
+	chunkSent map[int]int64
+}
+
+func newProgressTracker(u *chunkedUpload, chunks []byteRange) *progressTracker {
+	p := &progressTracker{
+		fn:          u.options.progress,
+		total:       u.size,
+		chunksTotal: len(chunks),
+		start:       time.Now(),
+		chunkSent:   map[int]int64{},
+		initial:     u.size,
+	}
+	for _, chunk := range chunks {
+		p.initial -= chunk.length()
+	}
+	return p
+}
+
+// track wraps the content of the chunk at index so its reads are reported.
+// A chunk sent again starts over, forgetting the bytes of the previous attempt
+func (p *progressTracker) track(index int, content func() io.Reader) func() io.Reader {
+	return func() io.Reader {
+		p.mu.Lock()
+		p.sent -= p.chunkSent[index]
+		p.chunkSent[index] = 0
+		p.mu.Unlock()
+
+		return &progressReader{r: content(), tracker: p, index: index}
+	}
+}
+
+func (p *progressTracker) add(index int, n int) {
+	p.mu.Lock()
+	p.sent += int64(n)
+	p.chunkSent[index] += int64(n)
+
+	progress := UploadProgress{
+		BytesSent:   p.initial + p.sent,
+		TotalBytes:  p.total,
+		ChunkIndex:  index,
+		ChunksTotal: p.chunksTotal,
+	}
+	if elapsed := time.Since(p.start).Seconds(); elapsed > 0 {
+		progress.Throughput = float64(p.sent) / elapsed
+	}
+	p.mu.Unlock()
+
+	p.fn(progress)
+}
+
+type progressReader struct {
+	r       io.Reader
+	tracker *progressTracker
+	index   int
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	if n > 0 {
+		r.tracker.add(r.index, n)
+	}
+	return n, err
+}
+
+// chunkSource reads the chunks of an upload content. An io.ReaderAt is read
+// in place, any other io.Reader is read forward into reused buffers
+type chunkSource struct {
+	r    io.Reader
+	ra   io.ReaderAt
+	size int64
+	base int64
+	pos  int64
+
+	mu      sync.Mutex
+	buffers [][]byte
+}
+
+func newChunkSource(r io.Reader, size int64) (*chunkSource, error) {
+	s := &chunkSource{r: r, size: size}
+
+	if ra, ok := r.(io.ReaderAt); ok {
+		s.ra = ra
+		if seeker, ok := r.(io.Seeker); ok {
+			base, err := seeker.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+			s.base = base
+		}
+	}
+
+	return s, nil
+}
+
+// read returns a function returning a new reader over chunk each time it is called.
+// Chunks must be read in ascending order, and release called once the chunk was sent
+func (s *chunkSource) read(chunk byteRange) (func() io.Reader, func(), error) {
+
+	if s.ra != nil {
+		offset, length := s.base+chunk.start, chunk.length()
+		return func() io.Reader {
+			return &exactReader{r: io.NewSectionReader(s.ra, offset, length), remaining: length, size: s.size}
+		}, func() {}, nil
+	}
+
+	if chunk.start < s.pos {
+		return nil, nil, fmt.Errorf("cannot read bytes %d-%d, the content was already read up to %d", chunk.start, chunk.end-1, s.pos)
+	}
+
+	if chunk.start > s.pos {
+		_, err := io.CopyN(ioutil.Discard, s.r, chunk.start-s.pos)
+		if err == io.EOF {
+			return nil, nil, errShortContent(s.size)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		s.pos = chunk.start
+	}
+
+	buf := s.buffer(chunk.length())
+	data := buf[:chunk.length()]
+	release := func() {
+		s.mu.Lock()
+		s.buffers = append(s.buffers, buf)
+		s.mu.Unlock()
+	}
+
+	_, err := io.ReadFull(s.r, data)
+	if err != nil {
+		release()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, nil, errShortContent(s.size)
+		}
+		return nil, nil, err
+	}
+	s.pos = chunk.end
+
+	return func() io.Reader {
+		return bytes.NewReader(data)
+	}, release, nil
+}
+
+// buffer returns a released buffer of at least length bytes, or a new one
+func (s *chunkSource) buffer(length int64) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, buf := range s.buffers {
+		if int64(cap(buf)) >= length {
+			s.buffers = append(s.buffers[:i], s.buffers[i+1:]...)
+			return buf
+		}
+	}
+	return make([]byte, length)
+}
+
+// exactReader fails instead of returning a short content when
+// its reader ends before the expected number of bytes
+type exactReader struct {
+	r         io.Reader
+	remaining int64
+	size      int64
+}
+
+func (r *exactReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.remaining -= int64(n)
+	if err == io.EOF && r.remaining > 0 {
+		err = errShortContent(r.size)
+	}
+	return n, err
+}
+
+// multipartEnvelope holds the bytes surrounding the content of
+// a multipart body with a single file part, so the content can be
+// streamed without copying it and the body length known in advance
+type multipartEnvelope struct {
+	head        []byte
+	tail        []byte
+	contentType string
+}
+
+func newMultipartEnvelope(name string, fields map[string]string) (*multipartEnvelope, error) {
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		err := writer.WriteField(key, fields[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		return nil, err
+	}
+	head := append([]byte(nil), buf.Bytes()...)
+
+	buf.Reset()
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &multipartEnvelope{
+		head:        head,
+		tail:        append([]byte(nil), buf.Bytes()...),
+		contentType: writer.FormDataContentType(),
+	}, nil
+}
+
+func (e *multipartEnvelope) body(content io.Reader) io.Reader {
+	return io.MultiReader(bytes.NewReader(e.head), content, bytes.NewReader(e.tail))
+}
+
+func (e *multipartEnvelope) size(contentSize int64) int64 {
+	return int64(len(e.head)) + contentSize + int64(len(e.tail))
+}
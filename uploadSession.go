@@ -0,0 +1,262 @@
+package apivideosdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ErrUploadSessionMismatch is returned when resuming the upload of a file
+// which changed since its UploadSession was saved
+var ErrUploadSessionMismatch = errors.New("upload session does not match the file to upload")
+
+// UploadSession records the progress of a video upload so it can be
+// resumed after a failure, even by another process.
+// See VideosService.UploadResumable
+type UploadSession struct {
+	VideoID string `json:"videoId,omitempty"`
+	// UploadToken is the token of a delegated upload, see DelegatedUploader
+	UploadToken string    `json:"uploadToken,omitempty"`
+	FileName    string    `json:"fileName"`
+	FileSize    int64     `json:"fileSize"`
+	FileModTime time.Time `json:"fileModTime"`
+	// AcknowledgedBytes is the end of the last chunk accepted by the API
+	AcknowledgedBytes int64 `json:"acknowledgedBytes"`
+}
+
+// LoadUploadSession reads the UploadSession saved at path
+func LoadUploadSession(path string) (*UploadSession, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := new(UploadSession)
+	err = json.Unmarshal(data, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Save writes the session at path, replacing any previous session atomically
+func (s *UploadSession) Save(path string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// sameTarget reports whether both sessions upload to the same video
+func (s *UploadSession) sameTarget(other *UploadSession) bool {
+	if s.UploadToken != "" || other.UploadToken != "" {
+		return s.UploadToken == other.UploadToken
+	}
+	return s.VideoID == other.VideoID
+}
+
+// sameFile reports whether both sessions upload the same file
+func (s *UploadSession) sameFile(other *UploadSession) bool {
+	return s.FileName == other.FileName && s.FileSize == other.FileSize && s.FileModTime.Equal(other.FileModTime)
+}
+
+// newFileUploadSession returns a session for the upload of file
+func newFileUploadSession(file *os.File) (*UploadSession, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadSession{
+		FileName:    info.Name(),
+		FileSize:    info.Size(),
+		FileModTime: info.ModTime(),
+	}, nil
+}
+
+// uploadResumable runs u, saving its progress at sessionPath after every accepted chunk.
+// If sessionPath holds a previous session for the same target and file, only the
+// ranges returned by missing are sent. It returns the session of the upload and whether
+// the API already had the whole content, in which case nothing was sent and v is untouched
+func (c *Client) uploadResumable(ctx context.Context, u *chunkedUpload, session *UploadSession, sessionPath string, missing func(*UploadSession) []byteRange, v interface{}) (*UploadSession, bool, error) {
+
+	saved, err := LoadUploadSession(sessionPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, false, err
+	}
+
+	if saved != nil && saved.sameTarget(session) {
+		if !saved.sameFile(session) {
+			return nil, false, ErrUploadSessionMismatch
+		}
+
+		session = saved
+		u.videoID = saved.VideoID
+		u.missing = missing(saved)
+		if len(u.missing) == 0 && u.size > 0 {
+			os.Remove(sessionPath)
+			return session, true, nil
+		}
+	} else {
+		err = session.Save(sessionPath)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	u.acknowledged = func(chunk byteRange) error {
+		if chunk.end > session.AcknowledgedBytes {
+			session.AcknowledgedBytes = chunk.end
+		}
+		if u.videoID != "" {
+			session.VideoID = u.videoID
+		}
+		return session.Save(sessionPath)
+	}
+
+	err = c.uploadChunks(ctx, u, v)
+	if err != nil {
+		return nil, false, err
+	}
+
+	os.Remove(sessionPath)
+
+	return session, false, nil
+}
+
+//UploadResumable upload a video in a container, saving an UploadSession at sessionPath
+//after every chunk accepted by the API.
+//If sessionPath holds the session of a previous upload of the same file to the same video,
+//only the bytes the API did not receive yet are sent.
+//The session is removed once the upload is complete
+func (s *VideosService) UploadResumable(videoID string, filePath string, sessionPath string, opts ...UploadOption) (*Video, error) {
+	return s.UploadResumableWithContext(context.Background(), videoID, filePath, sessionPath, opts...)
+}
+
+//UploadResumableWithContext is the same as UploadResumable with a context controlling the lifetime of the request
+func (s *VideosService) UploadResumableWithContext(ctx context.Context, videoID string, filePath string, sessionPath string, opts ...UploadOption) (*Video, error) {
+
+	err := checkVideoID(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, name, size, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	session, err := newFileUploadSession(file)
+	if err != nil {
+		return nil, err
+	}
+	session.VideoID = videoID
+
+	upload := &chunkedUpload{
+		urlStr:  fmt.Sprintf("%s/%s/source", videosBasePath, videoID),
+		name:    name,
+		r:       file,
+		size:    size,
+		options: newUploadOptions(opts),
+	}
+
+	missing := func(saved *UploadSession) []byteRange {
+		return s.missingRanges(ctx, videoID, size, saved.AcknowledgedBytes)
+	}
+
+	v := new(Video)
+
+	_, complete, err := s.client.uploadResumable(ctx, upload, session, sessionPath, missing, v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if complete {
+		return s.GetWithContext(ctx, videoID)
+	}
+
+	return v, nil
+}
+
+// missingRanges returns the ranges of the video source the API did not receive yet,
+// falling back to the bytes after the last acknowledged one when the ingest status is unknown
+func (s *VideosService) missingRanges(ctx context.Context, videoID string, size int64, acknowledged int64) []byteRange {
+
+	status, err := s.StatusWithContext(ctx, videoID)
+	if err != nil || status.Ingest == nil || len(status.Ingest.ReceivedBytes) == 0 {
+		return acknowledgedRanges(size, acknowledged)
+	}
+
+	received := make([]byteRange, 0, len(status.Ingest.ReceivedBytes))
+	for _, item := range status.Ingest.ReceivedBytes {
+		received = append(received, byteRange{int64(item.From), int64(item.To) + 1})
+	}
+
+	return complementRanges(received, size)
+}
+
+// acknowledgedRanges returns the bytes after the last acknowledged one
+func acknowledgedRanges(size int64, acknowledged int64) []byteRange {
+	if acknowledged >= size {
+		return []byteRange{}
+	}
+	return []byteRange{{acknowledged, size}}
+}
+
+// complementRanges returns the ranges of [0, size) not covered by received
+func complementRanges(received []byteRange, size int64) []byteRange {
+	sort.Slice(received, func(i, j int) bool {
+		return received[i].start < received[j].start
+	})
+
+	missing := []byteRange{}
+	var pos int64
+	for _, rg := range received {
+		if rg.start > pos {
+			missing = append(missing, byteRange{pos, minInt64(rg.start, size)})
+		}
+		if rg.end > pos {
+			pos = rg.end
+		}
+		if pos >= size {
+			return missing
+		}
+	}
+
+	if pos < size {
+		missing = append(missing, byteRange{pos, size})
+	}
+	return missing
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package apivideosdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestVideos_UploadResumable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "apivideo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sessionPath := filepath.Join(dir, "session.json")
+
+	var headers []string
+	failed := false
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Content-Range"))
+		if len(headers) == 2 && !failed {
+			failed = true
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ingest": {"status": "uploading", "filesize": 4194304, "receivedBytes": [{"from": 0, "to": 1048575, "total": 4194304}]}}`)
+	})
+
+	file := createTempFile(filepath.Join(dir, "test.video"), 4*1024*1024)
+	client.ChunkSize(1024 * 1024)
+
+	_, err = client.Videos.UploadResumable("vi4k0jvEUuaTdRAEjQ4Jfagz", file, sessionPath)
+	if err == nil {
+		t.Fatalf("Videos.UploadResumable should fail on the second chunk")
+	}
+
+	session, err := LoadUploadSession(sessionPath)
+	if err != nil {
+		t.Fatalf("Videos.UploadResumable should save the session: %v", err)
+	}
+	if session.AcknowledgedBytes != 1024*1024 || session.FileSize != 4*1024*1024 {
+		t.Errorf("Videos.UploadResumable session got=%#v", session)
+	}
+
+	headers = nil
+	video, err := client.Videos.UploadResumable("vi4k0jvEUuaTdRAEjQ4Jfagz", file, sessionPath)
+	if err != nil {
+		t.Errorf("Videos.UploadResumable error: %v", err)
+	}
+
+	expectedHeaders := []string{
+		"bytes 1048576-2097151/4194304",
+		"bytes 2097152-3145727/4194304",
+		"bytes 3145728-4194303/4194304",
+	}
+	if !reflect.DeepEqual(headers, expectedHeaders) {
+		t.Errorf("Videos.UploadResumable should only send missing bytes\n got=%#v\nwant=%#v", headers, expectedHeaders)
+	}
+
+	expected := &videoStructs[0]
+	if !reflect.DeepEqual(video, expected) {
+		t.Errorf("Videos.UploadResumable\n got=%#v\nwant=%#v", video, expected)
+	}
+
+	if _, err := os.Stat(sessionPath); !os.IsNotExist(err) {
+		t.Errorf("Videos.UploadResumable should remove the session once complete")
+	}
+}
+
+func TestVideos_UploadResumableMismatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "apivideo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sessionPath := filepath.Join(dir, "session.json")
+
+	file := createTempFile(filepath.Join(dir, "test.video"), 1024)
+
+	session := &UploadSession{
+		VideoID:           "vi4k0jvEUuaTdRAEjQ4Jfagz",
+		FileName:          "test.video",
+		FileSize:          2048,
+		AcknowledgedBytes: 512,
+	}
+	err = session.Save(sessionPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Videos.UploadResumable("vi4k0jvEUuaTdRAEjQ4Jfagz", file, sessionPath)
+	if err != ErrUploadSessionMismatch {
+		t.Errorf("Videos.UploadResumable error got=%v want=%v", err, ErrUploadSessionMismatch)
+	}
+}
+
+func TestComplementRanges(t *testing.T) {
+	tests := []struct {
+		received []byteRange
+		expected []byteRange
+	}{
+		{[]byteRange{}, []byteRange{{0, 100}}},
+		{[]byteRange{{0, 100}}, []byteRange{}},
+		{[]byteRange{{0, 10}, {20, 30}}, []byteRange{{10, 20}, {30, 100}}},
+		{[]byteRange{{50, 100}, {0, 20}}, []byteRange{{20, 50}}},
+		{[]byteRange{{0, 30}, {10, 40}, {90, 120}}, []byteRange{{40, 90}}},
+	}
+
+	for _, test := range tests {
+		missing := complementRanges(test.received, 100)
+		if !reflect.DeepEqual(missing, test.expected) {
+			t.Errorf("complementRanges(%v)\n got=%v\nwant=%v", test.received, missing, test.expected)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package apivideosdk
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+
+	"github.com/google/go-querystring/query"
 )
 
 const uploadTokensBasePath = "upload-tokens"
@@ -11,6 +15,17 @@ const uploadTokensBasePath = "upload-tokens"
 // See: https://docs.api.video/5.1/videos-delegated-upload
 type UploadTokensServiceI interface {
 	Generate() (*UploadToken, error)
+	GenerateWithContext(ctx context.Context) (*UploadToken, error)
+	GenerateWithTTL(ttl int) (*UploadToken, error)
+	GenerateWithTTLWithContext(ctx context.Context, ttl int) (*UploadToken, error)
+	Get(token string) (*UploadToken, error)
+	GetWithContext(ctx context.Context, token string) (*UploadToken, error)
+	List(opts *UploadTokenOpts) (*UploadTokenList, error)
+	ListWithContext(ctx context.Context, opts *UploadTokenOpts) (*UploadTokenList, error)
+	Iter(opts *UploadTokenOpts, iterOpts ...IterOption) *UploadTokenIterator
+	IterWithContext(ctx context.Context, opts *UploadTokenOpts, iterOpts ...IterOption) *UploadTokenIterator
+	Delete(token string) error
+	DeleteWithContext(ctx context.Context, token string) error
 }
 
 // UploadTokensService communicating with the Upload Tokens
@@ -22,12 +37,54 @@ type UploadTokensService struct {
 // UploadToken represents an api.video UploadToken
 type UploadToken struct {
 	Token string `json:"token,omitempty"`
+	// TTL is the lifetime of the token in seconds, 0 means it never expires
+	TTL       int       `json:"ttl,omitempty"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	ExpiresAt Timestamp `json:"expiresAt,omitempty"`
+}
+
+// UploadTokenRequest represents a request to generate an UploadToken
+type UploadTokenRequest struct {
+	TTL int `json:"ttl,omitempty"`
+}
+
+// UploadTokenList represents a list of upload tokens
+type UploadTokenList struct {
+	Data       []UploadToken `json:"data,omitempty"`
+	Pagination *Pagination   `json:"pagination,omitempty"`
+}
+
+// UploadTokenOpts represents a query string to search on upload tokens
+type UploadTokenOpts struct {
+	CurrentPage int       `url:"currentPage,omitempty"`
+	PageSize    int       `url:"pageSize,omitempty"`
+	SortBy      SortField `url:"sortBy,omitempty"`
+	SortOrder   SortOrder `url:"sortOrder,omitempty"`
 }
 
 //Generate returns a new generated UploadToken
 func (s *UploadTokensService) Generate() (*UploadToken, error) {
+	return s.GenerateWithContext(context.Background())
+}
 
-	req, err := s.client.prepareRequest(http.MethodPost, uploadTokensBasePath, nil)
+//GenerateWithContext is the same as Generate with a context controlling the lifetime of the request
+func (s *UploadTokensService) GenerateWithContext(ctx context.Context) (*UploadToken, error) {
+	return s.GenerateWithTTLWithContext(ctx, 0)
+}
+
+//GenerateWithTTL returns a new generated UploadToken expiring after ttl seconds
+func (s *UploadTokensService) GenerateWithTTL(ttl int) (*UploadToken, error) {
+	return s.GenerateWithTTLWithContext(context.Background(), ttl)
+}
+
+//GenerateWithTTLWithContext is the same as GenerateWithTTL with a context controlling the lifetime of the request
+func (s *UploadTokensService) GenerateWithTTLWithContext(ctx context.Context, ttl int) (*UploadToken, error) {
+
+	if ttl < 0 {
+		return nil, invalidf("TTL %d is invalid, it must be positive", ttl)
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, uploadTokensBasePath, &UploadTokenRequest{TTL: ttl})
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +98,127 @@ func (s *UploadTokensService) Generate() (*UploadToken, error) {
 
 	return ut, nil
 }
+
+//Get returns an UploadToken by token
+func (s *UploadTokensService) Get(token string) (*UploadToken, error) {
+	return s.GetWithContext(context.Background(), token)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *UploadTokensService) GetWithContext(ctx context.Context, token string) (*UploadToken, error) {
+
+	err := checkUploadToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", uploadTokensBasePath, token)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ut := new(UploadToken)
+	_, err = s.client.do(req, ut)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ut, nil
+}
+
+//List returns an UploadTokenList containing all upload tokens matching UploadTokenOpts
+func (s *UploadTokensService) List(opts *UploadTokenOpts) (*UploadTokenList, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *UploadTokensService) ListWithContext(ctx context.Context, opts *UploadTokenOpts) (*UploadTokenList, error) {
+
+	if opts != nil {
+		err := checkUploadTokenOpts(opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	v, err := query.Values(opts)
+
+	if err != nil {
+		return nil, err
+	}
+	qs := v.Encode()
+
+	path := fmt.Sprintf("%s?%s", uploadTokensBasePath, qs)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	utl := new(UploadTokenList)
+	_, err = s.client.do(req, utl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return utl, nil
+}
+
+//Iter returns an UploadTokenIterator over all upload tokens matching UploadTokenOpts, the pages are fetched as needed
+func (s *UploadTokensService) Iter(opts *UploadTokenOpts, iterOpts ...IterOption) *UploadTokenIterator {
+	return s.IterWithContext(context.Background(), opts, iterOpts...)
+}
+
+//IterWithContext is the same as Iter with a context controlling the lifetime of the requests
+func (s *UploadTokensService) IterWithContext(ctx context.Context, opts *UploadTokenOpts, iterOpts ...IterOption) *UploadTokenIterator {
+	o := UploadTokenOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(UploadTokenIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.ListWithContext(ctx, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
+//Delete an upload token, it can no longer be used to upload videos
+func (s *UploadTokensService) Delete(token string) error {
+	return s.DeleteWithContext(context.Background(), token)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *UploadTokensService) DeleteWithContext(ctx context.Context, token string) error {
+
+	err := checkUploadToken(token)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/%s", uploadTokensBasePath, token)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(req, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
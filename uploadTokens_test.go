@@ -1,18 +1,36 @@
 package apivideosdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"testing"
 )
 
-var tokenJSONResponse string = `{
-	"token": "toXXX"
-  }`
+var tokenJSONResponses = []string{`{
+	"token": "to1tcmSFHeYY5KzyhOqVKMKb",
+	"ttl": 3600,
+	"createdAt": "2019-12-16T08:25:51+00:00",
+	"expiresAt": "2019-12-16T09:25:51+00:00"
+  }`, `{
+	"token": "to37YfXRFYhBF6Me1qyRwvid",
+	"createdAt": "2019-12-17T10:12:09+00:00"
+  }`,
+}
 
-var tokenStruct = UploadToken{
-	Token: "toXXX",
+var tokenStructs = []UploadToken{
+	{
+		Token:     "to1tcmSFHeYY5KzyhOqVKMKb",
+		TTL:       3600,
+		CreatedAt: mustTimestamp("2019-12-16T08:25:51+00:00"),
+		ExpiresAt: mustTimestamp("2019-12-16T09:25:51+00:00"),
+	},
+	{
+		Token:     "to37YfXRFYhBF6Me1qyRwvid",
+		CreatedAt: mustTimestamp("2019-12-17T10:12:09+00:00"),
+	},
 }
 
 func TestUploadTokens_Generate(t *testing.T) {
@@ -20,7 +38,7 @@ func TestUploadTokens_Generate(t *testing.T) {
 	defer teardown()
 	mux.HandleFunc("/upload-tokens", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
-		fmt.Fprint(w, tokenJSONResponse)
+		fmt.Fprint(w, tokenJSONResponses[1])
 	})
 
 	token, err := client.UploadTokens.Generate()
@@ -28,8 +46,117 @@ func TestUploadTokens_Generate(t *testing.T) {
 		t.Errorf("UploadTokens.Generate error: %v", err)
 	}
 
-	expected := &tokenStruct
+	expected := &tokenStructs[1]
 	if !reflect.DeepEqual(token, expected) {
 		t.Errorf("UploadTokens.Generate\n got=%#v\nwant=%#v", token, expected)
 	}
 }
+
+func TestUploadTokens_GenerateWithTTL(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/upload-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		v := new(UploadTokenRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		if v.TTL != 3600 {
+			t.Errorf("Request body ttl got=%d want=%d", v.TTL, 3600)
+		}
+		fmt.Fprint(w, tokenJSONResponses[0])
+	})
+
+	token, err := client.UploadTokens.GenerateWithTTL(3600)
+	if err != nil {
+		t.Errorf("UploadTokens.GenerateWithTTL error: %v", err)
+	}
+
+	expected := &tokenStructs[0]
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("UploadTokens.GenerateWithTTL\n got=%#v\nwant=%#v", token, expected)
+	}
+
+	_, err = client.UploadTokens.GenerateWithTTL(-1)
+	if err == nil {
+		t.Errorf("UploadTokens.GenerateWithTTL should reject a negative ttl")
+	}
+}
+
+func TestUploadTokens_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/upload-tokens/to1tcmSFHeYY5KzyhOqVKMKb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, tokenJSONResponses[0])
+	})
+
+	token, err := client.UploadTokens.Get("to1tcmSFHeYY5KzyhOqVKMKb")
+	if err != nil {
+		t.Errorf("UploadTokens.Get error: %v", err)
+	}
+
+	expected := &tokenStructs[0]
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("UploadTokens.Get\n got=%#v\nwant=%#v", token, expected)
+	}
+
+	_, err = client.UploadTokens.Get("vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if err == nil {
+		t.Errorf("UploadTokens.Get should reject an invalid token")
+	}
+}
+
+func TestUploadTokens_List(t *testing.T) {
+	setup()
+	defer teardown()
+	JSONResp := fmt.Sprintf(
+		`{"data":[%s,%s], "pagination":%s}`,
+		tokenJSONResponses[0],
+		tokenJSONResponses[1],
+		paginationJSON)
+
+	mux.HandleFunc("/upload-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		expectedQuery := url.Values{
+			"currentPage": []string{"1"},
+			"pageSize":    []string{"25"},
+			"sortBy":      []string{"createdAt"},
+			"sortOrder":   []string{"desc"},
+		}
+		if !reflect.DeepEqual(r.URL.Query(), expectedQuery) {
+			t.Errorf("Request querystring\n got=%#v\nwant=%#v", r.URL.Query(), expectedQuery)
+		}
+		fmt.Fprint(w, JSONResp)
+	})
+
+	opts := &UploadTokenOpts{
+		CurrentPage: 1,
+		PageSize:    25,
+		SortBy:      "createdAt",
+		SortOrder:   "desc",
+	}
+	tokens, err := client.UploadTokens.List(opts)
+	if err != nil {
+		t.Errorf("UploadTokens.List error: %v", err)
+	}
+
+	expected := &UploadTokenList{
+		Data:       tokenStructs,
+		Pagination: &paginationStruct,
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("UploadTokens.List\n got=%#v\nwant=%#v", tokens, expected)
+	}
+}
+
+func TestUploadTokens_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/upload-tokens/to1tcmSFHeYY5KzyhOqVKMKb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	err := client.UploadTokens.Delete("to1tcmSFHeYY5KzyhOqVKMKb")
+	if err != nil {
+		t.Errorf("UploadTokens.Delete error: %v", err)
+	}
+}
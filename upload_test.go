@@ -0,0 +1,264 @@
+package apivideosdk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// reassemblyServer rebuilds an uploaded content from its Content-Range chunks,
+// checking every chunk is consistent with its range
+type reassemblyServer struct {
+	t       *testing.T
+	mu      sync.Mutex
+	content []byte
+	size    int64
+	chunks  int
+	// aborted tolerates chunks whose body is cut by the client
+	aborted bool
+}
+
+func (s *reassemblyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	file, _, err := r.FormFile("file")
+	if err != nil && s.aborted {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		s.t.Errorf("upload chunk without file part: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	data, _ := ioutil.ReadAll(file)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chunks++
+
+	header := r.Header.Get("Content-Range")
+	if header == "" {
+		s.content = data
+		s.size = int64(len(data))
+		fmt.Fprint(w, videoJSONResponses[0])
+		return
+	}
+
+	var start, end, size int64
+	_, err = fmt.Sscanf(header, "bytes %d-%d/%d", &start, &end, &size)
+	if err != nil {
+		s.t.Errorf("invalid Content-Range %q: %v", header, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if end-start+1 != int64(len(data)) || end >= size || start < 0 {
+		s.t.Errorf("Content-Range %q does not match the %d bytes of the chunk", header, len(data))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if s.content == nil {
+		s.content = make([]byte, size)
+		s.size = size
+	}
+	copy(s.content[start:], data)
+	fmt.Fprint(w, videoJSONResponses[0])
+}
+
+func TestUploadChunks_Reassembly(t *testing.T) {
+	const chunkSize = 1024
+
+	sizes := []int64{
+		0, 1, chunkSize - 1, chunkSize, chunkSize + 1,
+		2*chunkSize - 1, 2 * chunkSize, 2*chunkSize + 1,
+		5*chunkSize + 7,
+	}
+
+	sources := map[string]func([]byte) io.Reader{
+		"ReaderAt": func(b []byte) io.Reader {
+			return bytes.NewReader(b)
+		},
+		"Stream": func(b []byte) io.Reader {
+			return struct{ io.Reader }{bytes.NewReader(b)}
+		},
+		"ShortReads": func(b []byte) io.Reader {
+			return iotest.HalfReader(bytes.NewReader(b))
+		},
+		"OneByteReads": func(b []byte) io.Reader {
+			return iotest.OneByteReader(bytes.NewReader(b))
+		},
+	}
+
+	for name, source := range sources {
+		for _, size := range sizes {
+			t.Run(fmt.Sprintf("%s/%d", name, size), func(t *testing.T) {
+				setup()
+				defer teardown()
+
+				content := make([]byte, size)
+				rand.Read(content)
+
+				srv := &reassemblyServer{t: t}
+				mux.Handle("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", srv)
+
+				client.ChunkSize(chunkSize)
+				_, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", source(content), size)
+				if err != nil {
+					t.Fatalf("Videos.UploadFromReader error: %v", err)
+				}
+
+				expectedChunks := int((size + chunkSize - 1) / chunkSize)
+				if expectedChunks == 0 {
+					expectedChunks = 1
+				}
+				if srv.chunks != expectedChunks {
+					t.Errorf("Videos.UploadFromReader got %d chunks, want %d", srv.chunks, expectedChunks)
+				}
+				if srv.size != size || !bytes.Equal(srv.content, content) {
+					t.Errorf("Videos.UploadFromReader reassembled %d bytes differing from the %d bytes sent", srv.size, size)
+				}
+			})
+		}
+	}
+}
+
+func TestUploadChunks_ShortContent(t *testing.T) {
+	sources := map[string]io.Reader{
+		"ReaderAt": bytes.NewReader(make([]byte, 3000)),
+		"Stream":   struct{ io.Reader }{bytes.NewReader(make([]byte, 3000))},
+	}
+
+	for name, source := range sources {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			// The client cuts the body of the chunk it cannot read entirely
+			mux.Handle("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", &reassemblyServer{t: t, aborted: true})
+
+			client.ChunkSize(1024)
+			_, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", source, 4096)
+			if err == nil || !strings.Contains(err.Error(), "shorter than its declared size") {
+				t.Errorf("Videos.UploadFromReader error got=%v, want a short content error", err)
+			}
+		})
+	}
+}
+
+func TestContentRange(t *testing.T) {
+	tests := []struct {
+		chunk    byteRange
+		size     int64
+		expected string
+		valid    bool
+	}{
+		{byteRange{0, 1024}, 4096, "bytes 0-1023/4096", true},
+		{byteRange{4095, 4096}, 4096, "bytes 4095-4095/4096", true},
+		{byteRange{3072, 4097}, 4096, "", false},
+		{byteRange{10, 10}, 4096, "", false},
+		{byteRange{-1, 10}, 4096, "", false},
+	}
+
+	for _, test := range tests {
+		got, err := contentRange(test.chunk, test.size)
+		if (err == nil) != test.valid || got != test.expected {
+			t.Errorf("contentRange(%v, %d) got=%q, %v want=%q", test.chunk, test.size, got, err, test.expected)
+		}
+	}
+}
+
+func TestUploadChunks_Concurrency(t *testing.T) {
+	for name, wrap := range map[string]func(io.Reader) io.Reader{
+		"ReaderAt": func(r io.Reader) io.Reader { return r },
+		"Stream":   func(r io.Reader) io.Reader { return struct{ io.Reader }{r} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			const chunkSize = 1024
+			const nbChunks = 8
+			content := make([]byte, nbChunks*chunkSize-10)
+			rand.Read(content)
+
+			var mu sync.Mutex
+			var inFlight, maxInFlight, received int
+			srv := &reassemblyServer{t: t}
+			mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				if strings.HasPrefix(r.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", (nbChunks-1)*chunkSize)) && received != nbChunks-1 {
+					t.Errorf("Videos.UploadFromReader last chunk sent after %d chunks, want %d", received, nbChunks-1)
+				}
+				mu.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+				srv.ServeHTTP(w, r)
+
+				mu.Lock()
+				inFlight--
+				received++
+				mu.Unlock()
+			})
+
+			client.ChunkSize(chunkSize)
+			_, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", wrap(bytes.NewReader(content)), int64(len(content)), WithConcurrency(4))
+			if err != nil {
+				t.Fatalf("Videos.UploadFromReader error: %v", err)
+			}
+
+			if maxInFlight < 2 || maxInFlight > 4 {
+				t.Errorf("Videos.UploadFromReader got %d chunks in flight, want between 2 and 4", maxInFlight)
+			}
+			if !bytes.Equal(srv.content, content) {
+				t.Errorf("Videos.UploadFromReader reassembled content differs from the content sent")
+			}
+		})
+	}
+}
+
+func TestUploadChunks_ConcurrencyErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var count int
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+		if strings.HasPrefix(r.Header.Get("Content-Range"), "bytes 0-") || strings.HasPrefix(r.Header.Get("Content-Range"), "bytes 1024-") {
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	client.ChunkSize(1024)
+	_, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", bytes.NewReader(make([]byte, 8*1024)), 8*1024, WithConcurrency(2))
+
+	uploadError, ok := err.(*UploadError)
+	if !ok {
+		t.Fatalf("Videos.UploadFromReader error should be an *UploadError, got %#v", err)
+	}
+	if len(uploadError.Errors) != 2 || uploadError.Errors[0].Index != 0 || uploadError.Errors[1].Index != 1 {
+		t.Errorf("Videos.UploadFromReader errors got=%v", uploadError)
+	}
+	if _, ok := uploadError.Errors[0].Err.(*ErrorResponse); !ok {
+		t.Errorf("Videos.UploadFromReader chunk error should be an *ErrorResponse, got %#v", uploadError.Errors[0].Err)
+	}
+	if count != 2 {
+		t.Errorf("Videos.UploadFromReader should not start new chunks after a failure, got %d requests", count)
+	}
+}
@@ -1,7 +1,9 @@
 package apivideosdk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -15,14 +17,33 @@ const videosBasePath = "videos"
 // See: https://docs.api.video/5.1/videos
 type VideosServiceI interface {
 	Get(videoID string) (*Video, error)
+	GetWithContext(ctx context.Context, videoID string) (*Video, error)
 	List(opts *VideoOpts) (*VideoList, error)
+	ListWithContext(ctx context.Context, opts *VideoOpts) (*VideoList, error)
+	Iter(opts *VideoOpts, iterOpts ...IterOption) *VideoIterator
+	IterWithContext(ctx context.Context, opts *VideoOpts, iterOpts ...IterOption) *VideoIterator
 	Create(createRequest *VideoRequest) (*Video, error)
+	CreateWithContext(ctx context.Context, createRequest *VideoRequest) (*Video, error)
 	Update(videoID string, updateRequest *VideoRequest) (*Video, error)
+	UpdateWithContext(ctx context.Context, videoID string, updateRequest *VideoRequest) (*Video, error)
 	Delete(videoID string) error
-	Upload(videoID string, filePath string) (*Video, error)
+	DeleteWithContext(ctx context.Context, videoID string) error
+	Upload(videoID string, filePath string, opts ...UploadOption) (*Video, error)
+	UploadWithContext(ctx context.Context, videoID string, filePath string, opts ...UploadOption) (*Video, error)
+	UploadFromReader(videoID string, name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error)
+	UploadFromReaderWithContext(ctx context.Context, videoID string, name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error)
+	UploadResumable(videoID string, filePath string, sessionPath string, opts ...UploadOption) (*Video, error)
+	UploadResumableWithContext(ctx context.Context, videoID string, filePath string, sessionPath string, opts ...UploadOption) (*Video, error)
 	Status(videoID string) (*VideoStatus, error)
+	StatusWithContext(ctx context.Context, videoID string) (*VideoStatus, error)
+	WaitUntilPlayable(ctx context.Context, videoID string, opts *WaitOpts) (*VideoStatus, error)
+	WatchStatus(ctx context.Context, videoIDs []string, opts *WatchOpts) (<-chan StatusEvent, error)
 	PickThumbnail(videoID string, timecode string) (*Video, error)
+	PickThumbnailWithContext(ctx context.Context, videoID string, timecode string) (*Video, error)
 	UploadThumbnail(videoID string, filePath string) (*Video, error)
+	UploadThumbnailWithContext(ctx context.Context, videoID string, filePath string) (*Video, error)
+	UploadThumbnailFromReader(videoID string, name string, r io.Reader) (*Video, error)
+	UploadThumbnailFromReaderWithContext(ctx context.Context, videoID string, name string, r io.Reader) (*Video, error)
 }
 
 // VideosService communicating with the Videos
@@ -36,8 +57,8 @@ type Video struct {
 	VideoID     string     `json:"videoId,omitempty"`
 	Title       string     `json:"title,omitempty"`
 	Description string     `json:"description,omitempty"`
-	PublishedAt string     `json:"publishedAt,omitempty"`
-	UpdatedAt   string     `json:"updatedAt,omitempty"`
+	PublishedAt Timestamp  `json:"publishedAt,omitempty"`
+	UpdatedAt   Timestamp  `json:"updatedAt,omitempty"`
 	Tags        []string   `json:"tags,omitempty"`
 	Metadata    []Metadata `json:"metadata,omitempty"`
 	Source      *Source    `json:"source,omitempty"`
@@ -56,9 +77,10 @@ type VideoRequest struct {
 	Metadata    []Metadata `json:"metadata,omitempty"`
 	Source      string     `json:"source,omitempty"`
 	PlayerID    string     `json:"playerId,omitempty"`
-	Public      bool       `json:"public,omitempty"`
-	Panoramic   bool       `json:"panoramic"`
-	Mp4Support  bool       `json:"mp4Support"`
+	// Public, Panoramic and Mp4Support are only sent when set, use Bool to set them
+	Public     *bool `json:"public,omitempty"`
+	Panoramic  *bool `json:"panoramic,omitempty"`
+	Mp4Support *bool `json:"mp4Support,omitempty"`
 }
 
 //VideoStatus represents the encoding status of one video
@@ -69,7 +91,7 @@ type VideoStatus struct {
 
 //Ingest represents the ingest status of one video
 type Ingest struct {
-	Status        string              `json:"status,omitempty"`
+	Status        IngestStatus        `json:"status,omitempty"`
 	Filesize      int                 `json:"filesize,omitempty"`
 	ReceivedBytes []ReceivedBytesItem `json:"receivedBytes,omitempty"`
 }
@@ -90,8 +112,8 @@ type Encoding struct {
 
 //Quality represents a quality
 type Quality struct {
-	Quality string `json:"quality,omitempty"`
-	Status  string `json:"status,omitempty"`
+	Quality QualityName   `json:"quality,omitempty"`
+	Status  QualityStatus `json:"status,omitempty"`
 }
 
 //EncodingMetadata represents a encoding metadata
@@ -117,8 +139,8 @@ type VideoList struct {
 type VideoOpts struct {
 	CurrentPage  int               `url:"currentPage,omitempty"`
 	PageSize     int               `url:"pageSize,omitempty"`
-	SortBy       string            `url:"sortBy,omitempty"`
-	SortOrder    string            `url:"sortOrder,omitempty"`
+	SortBy       SortField         `url:"sortBy,omitempty"`
+	SortOrder    SortOrder         `url:"sortOrder,omitempty"`
 	Title        string            `url:"title,omitempty"`
 	Tags         []string          `url:"tags,brackets,omitempty"`
 	Description  string            `url:"description,omitempty"`
@@ -155,6 +177,11 @@ type Assets struct {
 
 //Get returns a Video by id
 func (s *VideosService) Get(videoID string) (*Video, error) {
+	return s.GetWithContext(context.Background(), videoID)
+}
+
+//GetWithContext is the same as Get with a context controlling the lifetime of the request
+func (s *VideosService) GetWithContext(ctx context.Context, videoID string) (*Video, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -163,7 +190,7 @@ func (s *VideosService) Get(videoID string) (*Video, error) {
 
 	path := fmt.Sprintf("%s/%s", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +207,11 @@ func (s *VideosService) Get(videoID string) (*Video, error) {
 
 //List returns a VideoList containing all videos matching VideoOpts
 func (s *VideosService) List(opts *VideoOpts) (*VideoList, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+//ListWithContext is the same as List with a context controlling the lifetime of the request
+func (s *VideosService) ListWithContext(ctx context.Context, opts *VideoOpts) (*VideoList, error) {
 
 	err := checkOpts(opts)
 	if err != nil {
@@ -203,7 +235,7 @@ func (s *VideosService) List(opts *VideoOpts) (*VideoList, error) {
 
 	path := fmt.Sprintf("%s?%s", videosBasePath, qs)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -218,10 +250,41 @@ func (s *VideosService) List(opts *VideoOpts) (*VideoList, error) {
 	return vl, nil
 }
 
+//Iter returns a VideoIterator over all videos matching VideoOpts, the pages are fetched as needed
+func (s *VideosService) Iter(opts *VideoOpts, iterOpts ...IterOption) *VideoIterator {
+	return s.IterWithContext(context.Background(), opts, iterOpts...)
+}
+
+//IterWithContext is the same as Iter with a context controlling the lifetime of the requests
+func (s *VideosService) IterWithContext(ctx context.Context, opts *VideoOpts, iterOpts ...IterOption) *VideoIterator {
+	o := VideoOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	it := new(VideoIterator)
+	it.pager = newPager(ctx, o.CurrentPage, iterOpts, func(ctx context.Context, page int) (int, *Pagination, error) {
+		o.CurrentPage = page
+		l, err := s.ListWithContext(ctx, &o)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.items = l.Data
+		return len(l.Data), l.Pagination, nil
+	})
+
+	return it
+}
+
 //Create a video container and returns it
 func (s *VideosService) Create(createRequest *VideoRequest) (*Video, error) {
+	return s.CreateWithContext(context.Background(), createRequest)
+}
+
+//CreateWithContext is the same as Create with a context controlling the lifetime of the request
+func (s *VideosService) CreateWithContext(ctx context.Context, createRequest *VideoRequest) (*Video, error) {
 
-	req, err := s.client.prepareRequest(http.MethodPost, videosBasePath, createRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, videosBasePath, createRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +301,11 @@ func (s *VideosService) Create(createRequest *VideoRequest) (*Video, error) {
 
 //Update a video container and returns it
 func (s *VideosService) Update(videoID string, updateRequest *VideoRequest) (*Video, error) {
+	return s.UpdateWithContext(context.Background(), videoID, updateRequest)
+}
+
+//UpdateWithContext is the same as Update with a context controlling the lifetime of the request
+func (s *VideosService) UpdateWithContext(ctx context.Context, videoID string, updateRequest *VideoRequest) (*Video, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -246,7 +314,7 @@ func (s *VideosService) Update(videoID string, updateRequest *VideoRequest) (*Vi
 
 	path := fmt.Sprintf("%s/%s", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodPatch, path, updateRequest)
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, path, updateRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +331,11 @@ func (s *VideosService) Update(videoID string, updateRequest *VideoRequest) (*Vi
 
 //Delete a video container
 func (s *VideosService) Delete(videoID string) error {
+	return s.DeleteWithContext(context.Background(), videoID)
+}
+
+//DeleteWithContext is the same as Delete with a context controlling the lifetime of the request
+func (s *VideosService) DeleteWithContext(ctx context.Context, videoID string) error {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -271,7 +344,7 @@ func (s *VideosService) Delete(videoID string) error {
 
 	path := fmt.Sprintf("%s/%s", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodDelete, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
@@ -287,30 +360,65 @@ func (s *VideosService) Delete(videoID string) error {
 
 //Upload a video in a container.
 //The upload is chuncked if the file size is more than 128MB
-func (s *VideosService) Upload(videoID string, filePath string) (*Video, error) {
+func (s *VideosService) Upload(videoID string, filePath string, opts ...UploadOption) (*Video, error) {
+	return s.UploadWithContext(context.Background(), videoID, filePath, opts...)
+}
 
-	path := fmt.Sprintf("%s/%s/source", videosBasePath, videoID)
+//UploadWithContext is the same as Upload with a context controlling the lifetime of the request
+func (s *VideosService) UploadWithContext(ctx context.Context, videoID string, filePath string, opts ...UploadOption) (*Video, error) {
+
+	file, name, size, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadFromReaderWithContext(ctx, videoID, name, file, size, opts...)
+}
+
+//UploadFromReader upload a video in a container from r.
+//name is the file name sent to the API and size the number of bytes to read from r.
+//The upload is chuncked if the size is more than 128MB
+func (s *VideosService) UploadFromReader(videoID string, name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error) {
+	return s.UploadFromReaderWithContext(context.Background(), videoID, name, r, size, opts...)
+}
 
-	requests, err := s.client.prepareRangeRequests(path, filePath)
+//UploadFromReaderWithContext is the same as UploadFromReader with a context controlling the lifetime of the request
+func (s *VideosService) UploadFromReaderWithContext(ctx context.Context, videoID string, name string, r io.Reader, size int64, opts ...UploadOption) (*Video, error) {
 
+	err := checkUploadSize(size)
 	if err != nil {
 		return nil, err
 	}
 
+	path := fmt.Sprintf("%s/%s/source", videosBasePath, videoID)
+
 	v := new(Video)
 
-	for _, req := range requests {
-		_, err = s.client.do(req, v)
+	upload := &chunkedUpload{
+		urlStr:  path,
+		name:    name,
+		r:       r,
+		size:    size,
+		options: newUploadOptions(opts),
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	err = s.client.uploadChunks(ctx, upload, v)
+
+	if err != nil {
+		return nil, err
 	}
+
 	return v, nil
 }
 
 //Status returns the  status of encoding and ingest of a video
 func (s *VideosService) Status(videoID string) (*VideoStatus, error) {
+	return s.StatusWithContext(context.Background(), videoID)
+}
+
+//StatusWithContext is the same as Status with a context controlling the lifetime of the request
+func (s *VideosService) StatusWithContext(ctx context.Context, videoID string) (*VideoStatus, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -319,7 +427,7 @@ func (s *VideosService) Status(videoID string) (*VideoStatus, error) {
 
 	path := fmt.Sprintf("%s/%s/status", videosBasePath, videoID)
 
-	req, err := s.client.prepareRequest(http.MethodGet, path, nil)
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -336,6 +444,11 @@ func (s *VideosService) Status(videoID string) (*VideoStatus, error) {
 
 //PickThumbnail change the thumbnail of a video with a timecode
 func (s *VideosService) PickThumbnail(videoID string, timecode string) (*Video, error) {
+	return s.PickThumbnailWithContext(context.Background(), videoID, timecode)
+}
+
+//PickThumbnailWithContext is the same as PickThumbnail with a context controlling the lifetime of the request
+func (s *VideosService) PickThumbnailWithContext(ctx context.Context, videoID string, timecode string) (*Video, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -353,7 +466,7 @@ func (s *VideosService) PickThumbnail(videoID string, timecode string) (*Video,
 		"timecode": timecode,
 	}
 
-	req, err := s.client.prepareRequest(http.MethodPatch, path, body)
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +483,28 @@ func (s *VideosService) PickThumbnail(videoID string, timecode string) (*Video,
 
 //UploadThumbnail upload the thumbnail of a video
 func (s *VideosService) UploadThumbnail(videoID string, filePath string) (*Video, error) {
+	return s.UploadThumbnailWithContext(context.Background(), videoID, filePath)
+}
+
+//UploadThumbnailWithContext is the same as UploadThumbnail with a context controlling the lifetime of the request
+func (s *VideosService) UploadThumbnailWithContext(ctx context.Context, videoID string, filePath string) (*Video, error) {
+
+	file, name, _, err := openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return s.UploadThumbnailFromReaderWithContext(ctx, videoID, name, file)
+}
+
+//UploadThumbnailFromReader upload the thumbnail of a video from r, name is the file name sent to the API
+func (s *VideosService) UploadThumbnailFromReader(videoID string, name string, r io.Reader) (*Video, error) {
+	return s.UploadThumbnailFromReaderWithContext(context.Background(), videoID, name, r)
+}
+
+//UploadThumbnailFromReaderWithContext is the same as UploadThumbnailFromReader with a context controlling the lifetime of the request
+func (s *VideosService) UploadThumbnailFromReaderWithContext(ctx context.Context, videoID string, name string, r io.Reader) (*Video, error) {
 
 	err := checkVideoID(videoID)
 	if err != nil {
@@ -378,7 +513,7 @@ func (s *VideosService) UploadThumbnail(videoID string, filePath string) (*Video
 
 	path := fmt.Sprintf("%s/%s/thumbnail", videosBasePath, videoID)
 
-	req, err := s.client.prepareUploadRequest(path, filePath, nil)
+	req, err := s.client.prepareUploadRequest(ctx, path, name, r, nil)
 
 	if err != nil {
 		return nil, err
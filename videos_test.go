@@ -1,12 +1,19 @@
 package apivideosdk
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
+	"sync/atomic"
 	"testing"
 )
 
@@ -82,8 +89,8 @@ var videoStructs = []Video{
 		VideoID:     "vi4k0jvEUuaTdRAEjQ4Jfagz",
 		Title:       "Maths video",
 		Description: "An amazing video explaining the string theory",
-		PublishedAt: "2019-07-14T23:36:18.598Z",
-		UpdatedAt:   "2019-07-14T23:49:18.598Z",
+		PublishedAt: mustTimestamp("2019-07-14T23:36:18.598Z"),
+		UpdatedAt:   mustTimestamp("2019-07-14T23:49:18.598Z"),
 		Tags:        []string{"maths", "string theory", "video"},
 		Metadata: []Metadata{
 			{
@@ -113,8 +120,8 @@ var videoStructs = []Video{
 		VideoID:     "vi6HangYsow3vXxwdx3YMlAb",
 		Title:       "Maths video 2",
 		Description: "An amazing video explaining the string theory 2",
-		PublishedAt: "2019-07-16T23:36:18.598Z",
-		UpdatedAt:   "2019-07-16T23:49:18.598Z",
+		PublishedAt: mustTimestamp("2019-07-16T23:36:18.598Z"),
+		UpdatedAt:   mustTimestamp("2019-07-16T23:49:18.598Z"),
 		Tags:        []string{"maths", "string theory"},
 		Metadata: []Metadata{
 			{
@@ -164,8 +171,8 @@ var videoRequestJSON = `{
 var videoRequestStruct = VideoRequest{
 	Title:       "Maths video",
 	Description: "An amazing video explaining the string theory",
-	Public:      true,
-	Panoramic:   false,
+	Public:      Bool(true),
+	Panoramic:   Bool(false),
 	PlayerID:    "pl45KFKdlddgk654dspkze",
 	Tags:        []string{"maths", "string theory", "video"},
 	Metadata: []Metadata{
@@ -178,7 +185,7 @@ var videoRequestStruct = VideoRequest{
 			Value: "Tutorial",
 		},
 	},
-	Mp4Support: true,
+	Mp4Support: Bool(true),
 }
 
 var videoStatusJSONResponse = `{
@@ -489,6 +496,32 @@ func TestVideos_Update(t *testing.T) {
 	}
 }
 
+func TestVideos_UpdatePartial(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		expectedBody := map[string]interface{}{
+			"public": false,
+		}
+		var v map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+
+		if !reflect.DeepEqual(v, expectedBody) {
+			t.Errorf("Request body should only contain the fields set\n got=%#v\nwant=%#v", v, expectedBody)
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	_, err := client.Videos.Update("vi4k0jvEUuaTdRAEjQ4Jfagz", &VideoRequest{Public: Bool(false)})
+	if err != nil {
+		t.Errorf("Videos.Update error: %v", err)
+	}
+}
+
 func TestVideos_Delete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -534,6 +567,59 @@ func TestVideos_Upload(t *testing.T) {
 	}
 }
 
+func TestVideos_UploadFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+	content := bytes.Repeat([]byte("api.video"), 1024)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Videos.UploadFromReader file part error: %v", err)
+		}
+		data, _ := ioutil.ReadAll(file)
+		if !bytes.Equal(data, content) || header.Filename != "video.mp4" {
+			t.Errorf("Videos.UploadFromReader got %d bytes named %q, want %d bytes named %q", len(data), header.Filename, len(content), "video.mp4")
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	video, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Errorf("Videos.UploadFromReader error: %v", err)
+	}
+
+	expected := &videoStructs[0]
+	if !reflect.DeepEqual(video, expected) {
+		t.Errorf("Videos.UploadFromReader\n got=%#v\nwant=%#v", video, expected)
+	}
+}
+
+func TestVideos_UploadFromReaderNegativeSize(t *testing.T) {
+	setup()
+	defer teardown()
+	var requests int32
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	video, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", bytes.NewReader([]byte("content")), -1)
+	if !errors.Is(err, ErrValidation) || video != nil {
+		t.Errorf("Videos.UploadFromReader with a negative size should fail with ErrValidation, got %v, %v", video, err)
+	}
+
+	delegated := NewDelegatedUploader("to1tcmSFHeYY5KzyhOqVKMKb", WithBaseURL(client.BaseURL))
+	video, err = delegated.UploadFromReader("video.mp4", bytes.NewReader([]byte("content")), -1)
+	if !errors.Is(err, ErrValidation) || video != nil {
+		t.Errorf("DelegatedUploader.UploadFromReader with a negative size should fail with ErrValidation, got %v, %v", video, err)
+	}
+
+	if requests != 0 {
+		t.Errorf("No chunk should be sent, got %d requests", requests)
+	}
+}
+
 func TestVideos_ChunkedUpload(t *testing.T) {
 	setup()
 	defer teardown()
@@ -578,6 +664,81 @@ func TestVideos_ChunkedUpload(t *testing.T) {
 	}
 }
 
+func TestVideos_ChunkedUploadFromReader(t *testing.T) {
+	setup()
+	defer teardown()
+	content := make([]byte, 5*1024*1024+17)
+	rand.Read(content)
+
+	received := new(bytes.Buffer)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Videos.ChunkedUploadFromReader file part error: %v", err)
+		}
+		io.Copy(received, file)
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	// Hide the io.ReaderAt implementation of bytes.Reader to stream the content
+	r := struct{ io.Reader }{bytes.NewReader(content)}
+
+	client.ChunkSize(2 * 1024 * 1024)
+	_, err := client.Videos.UploadFromReader("vi4k0jvEUuaTdRAEjQ4Jfagz", "video.mp4", r, int64(len(content)))
+	if err != nil {
+		t.Errorf("Videos.ChunkedUploadFromReader error: %v", err)
+	}
+
+	if !bytes.Equal(received.Bytes(), content) {
+		t.Errorf("Videos.ChunkedUploadFromReader received %d bytes differing from the %d bytes sent", received.Len(), len(content))
+	}
+}
+
+func TestVideos_UploadWithProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 2})(client)
+
+	var count int
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		count++
+		if count == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, videoJSONResponses[0])
+	})
+
+	filesize := int64(5 * 1024 * 1024)
+	file := createTempFile("test.video", filesize)
+	defer os.Remove(file)
+
+	var updates []UploadProgress
+	client.ChunkSize(2 * 1024 * 1024)
+	_, err := client.Videos.Upload("vi4k0jvEUuaTdRAEjQ4Jfagz", file, WithProgress(func(p UploadProgress) {
+		updates = append(updates, p)
+	}))
+	if err != nil {
+		t.Errorf("Videos.Upload error: %v", err)
+	}
+
+	if len(updates) == 0 {
+		t.Fatalf("Videos.Upload progress should be reported")
+	}
+
+	last := updates[len(updates)-1]
+	if last.BytesSent != filesize || last.TotalBytes != filesize {
+		t.Errorf("Videos.Upload last progress got %d/%d bytes, want %d/%d", last.BytesSent, last.TotalBytes, filesize, filesize)
+	}
+	if last.ChunkIndex != 2 || last.ChunksTotal != 3 {
+		t.Errorf("Videos.Upload last progress got chunk %d/%d, want 2/3", last.ChunkIndex, last.ChunksTotal)
+	}
+	if last.Throughput <= 0 {
+		t.Errorf("Videos.Upload progress throughput should be positive, got %f", last.Throughput)
+	}
+}
+
 func TestVideos_Status(t *testing.T) {
 	setup()
 	defer teardown()
@@ -640,3 +801,44 @@ func TestVideos_UploadThumbnail(t *testing.T) {
 		t.Errorf("Videos.UploadThumbnail\n got=%#v\nwant=%#v", video, expected)
 	}
 }
+
+func TestVideos_GetWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Videos.GetWithContext should not reach the API with a canceled context")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.Videos.GetWithContext(ctx, "vi4k0jvEUuaTdRAEjQ4Jfagz")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Videos.GetWithContext error got=%v want=%v", err, context.Canceled)
+	}
+}
+
+func TestVideos_ChunkedUploadWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+	var count int
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/source", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, videoJSONResponses[0])
+		count++
+		cancel()
+	})
+
+	file := createTempFile("test.video", 8*1024*1024)
+	defer os.Remove(file)
+
+	client.ChunkSize(2 * 1024 * 1024)
+	_, err := client.Videos.UploadWithContext(ctx, "vi4k0jvEUuaTdRAEjQ4Jfagz", file)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Videos.UploadWithContext error got=%v want=%v", err, context.Canceled)
+	}
+
+	if count != 1 {
+		t.Errorf("Videos.UploadWithContext should stop after the first chunk, got %d calls", count)
+	}
+}
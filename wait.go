@@ -0,0 +1,143 @@
+package apivideosdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+// WaitOpts configures VideosService.WaitUntilPlayable
+type WaitOpts struct {
+	// PollInterval is the delay between the first status requests, 2s by default
+	PollInterval time.Duration
+	// Backoff multiplies the delay after every status request, a value lower
+	// than 1 keeps the delay constant
+	Backoff float64
+	// MaxPollInterval caps the delay between two status requests, 30s by default
+	MaxPollInterval time.Duration
+	// Timeout stops waiting after the given duration, 0 means no timeout
+	// other than the one of the context
+	Timeout time.Duration
+	// Quality waits for this quality to be encoded
+	// instead of the video to be playable, a QualityUnavailableError is
+	// returned when the encoding ends without it
+	Quality QualityName
+}
+
+// EncodingFailedError is returned when the quality waited for failed to encode
+type EncodingFailedError struct {
+	VideoID string
+	Quality QualityName
+}
+
+func (e *EncodingFailedError) Error() string {
+	return fmt.Sprintf("encoding of quality %s of video %s failed", e.Quality, e.VideoID)
+}
+
+// QualityUnavailableError is returned when the video is playable and all its qualities
+// are encoded or failed, without the quality waited for
+type QualityUnavailableError struct {
+	VideoID string
+	Quality QualityName
+}
+
+func (e *QualityUnavailableError) Error() string {
+	return fmt.Sprintf("quality %s of video %s is not available", e.Quality, e.VideoID)
+}
+
+//WaitUntilPlayable polls the status of a video until it is playable, or until
+//the quality set in opts is encoded, and returns the final VideoStatus.
+//When the wait is interrupted by the timeout or the context, the last
+//status received is returned along with the error
+func (s *VideosService) WaitUntilPlayable(ctx context.Context, videoID string, opts *WaitOpts) (*VideoStatus, error) {
+
+	err := checkVideoID(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	o := WaitOpts{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Quality != "" && !o.Quality.Valid() {
+		return nil, invalidf("Quality value %s is invalid", o.Quality)
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPollInterval
+	}
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = defaultMaxPollInterval
+	}
+
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	var last *VideoStatus
+	interval := o.PollInterval
+	for {
+		status, err := s.StatusWithContext(ctx, videoID)
+		if err != nil {
+			return last, err
+		}
+		last = status
+
+		done, err := isWaitDone(videoID, status, o.Quality)
+		if done || err != nil {
+			return status, err
+		}
+
+		err = sleepContext(ctx, interval)
+		if err != nil {
+			return last, err
+		}
+
+		if o.Backoff > 1 {
+			interval = time.Duration(float64(interval) * o.Backoff)
+		}
+		if interval > o.MaxPollInterval {
+			interval = o.MaxPollInterval
+		}
+	}
+}
+
+// isWaitDone reports whether status is the one waited for
+func isWaitDone(videoID string, status *VideoStatus, quality QualityName) (bool, error) {
+	if status.Encoding == nil {
+		return false, nil
+	}
+
+	if quality == "" {
+		return status.Encoding.Playable, nil
+	}
+
+	finished := len(status.Encoding.Qualities) > 0
+	for _, q := range status.Encoding.Qualities {
+		if q.Status != QualityEncoded && q.Status != QualityFailed {
+			finished = false
+		}
+		if q.Quality != quality {
+			continue
+		}
+		switch q.Status {
+		case QualityEncoded:
+			return true, nil
+		case QualityFailed:
+			return false, &EncodingFailedError{VideoID: videoID, Quality: quality}
+		}
+	}
+
+	// The encoding is over without the quality, it will never be listed
+	if status.Encoding.Playable && finished {
+		return false, &QualityUnavailableError{VideoID: videoID, Quality: quality}
+	}
+	return false, nil
+}
@@ -0,0 +1,114 @@
+package apivideosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// statusSequence serves the given statuses in order, repeating the last one
+func statusSequence(statuses ...string) (http.HandlerFunc, *int) {
+	calls := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		i := calls
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		calls++
+		fmt.Fprint(w, statuses[i])
+	}, &calls
+}
+
+func TestVideos_WaitUntilPlayable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, calls := statusSequence(
+		`{"ingest": {"status": "uploaded"}}`,
+		`{"encoding": {"playable": false, "qualities": [{"quality": "360p", "status": "encoding"}]}}`,
+		`{"encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "1080p", "status": "encoding"}]}}`,
+	)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	status, err := client.Videos.WaitUntilPlayable(context.Background(), "vi4k0jvEUuaTdRAEjQ4Jfagz", &WaitOpts{PollInterval: time.Millisecond, Backoff: 2})
+	if err != nil {
+		t.Fatalf("Videos.WaitUntilPlayable error: %v", err)
+	}
+	if !status.Encoding.Playable || *calls != 3 {
+		t.Errorf("Videos.WaitUntilPlayable should poll until playable, got %#v after %d calls", status.Encoding, *calls)
+	}
+}
+
+func TestVideos_WaitUntilPlayableQuality(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, calls := statusSequence(
+		`{"encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "1080p", "status": "encoding"}]}}`,
+		`{"encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "1080p", "status": "encoded"}]}}`,
+	)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	_, err := client.Videos.WaitUntilPlayable(context.Background(), "vi4k0jvEUuaTdRAEjQ4Jfagz", &WaitOpts{PollInterval: time.Millisecond, Quality: "1080p"})
+	if err != nil {
+		t.Fatalf("Videos.WaitUntilPlayable error: %v", err)
+	}
+	if *calls != 2 {
+		t.Errorf("Videos.WaitUntilPlayable should wait for the quality to be encoded, got %d calls", *calls)
+	}
+}
+
+func TestVideos_WaitUntilPlayableFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, _ := statusSequence(
+		`{"encoding": {"playable": true, "qualities": [{"quality": "1080p", "status": "failed"}]}}`,
+	)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	_, err := client.Videos.WaitUntilPlayable(context.Background(), "vi4k0jvEUuaTdRAEjQ4Jfagz", &WaitOpts{PollInterval: time.Millisecond, Quality: "1080p"})
+	var failed *EncodingFailedError
+	if !errors.As(err, &failed) || failed.Quality != "1080p" {
+		t.Errorf("Videos.WaitUntilPlayable error got=%v want EncodingFailedError", err)
+	}
+}
+
+func TestVideos_WaitUntilPlayableUnavailable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, calls := statusSequence(
+		`{"encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "720p", "status": "encoding"}]}}`,
+		`{"encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "720p", "status": "encoded"}]}}`,
+	)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	_, err := client.Videos.WaitUntilPlayable(context.Background(), "vi4k0jvEUuaTdRAEjQ4Jfagz", &WaitOpts{PollInterval: time.Millisecond, Quality: "2160p"})
+	var unavailable *QualityUnavailableError
+	if !errors.As(err, &unavailable) || unavailable.Quality != "2160p" {
+		t.Errorf("Videos.WaitUntilPlayable error got=%v want QualityUnavailableError", err)
+	}
+	if *calls != 2 {
+		t.Errorf("Videos.WaitUntilPlayable should stop once all the qualities are encoded, got %d calls", *calls)
+	}
+}
+
+func TestVideos_WaitUntilPlayableTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, _ := statusSequence(`{"encoding": {"playable": false}}`)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	status, err := client.Videos.WaitUntilPlayable(context.Background(), "vi4k0jvEUuaTdRAEjQ4Jfagz", &WaitOpts{PollInterval: 5 * time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Videos.WaitUntilPlayable error got=%v want=%v", err, context.DeadlineExceeded)
+	}
+	if status == nil || status.Encoding == nil || status.Encoding.Playable {
+		t.Errorf("Videos.WaitUntilPlayable should return the last status, got %#v", status)
+	}
+}
@@ -0,0 +1,235 @@
+package apivideosdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// StatusEventType is the kind of change reported by a StatusEvent
+type StatusEventType string
+
+// Types of StatusEvent
+const (
+	// EventIngestStarted is sent once the API started receiving the video source
+	EventIngestStarted StatusEventType = "ingestStarted"
+	// EventBytesReceived is sent when the number of bytes received by the API changed
+	EventBytesReceived StatusEventType = "bytesReceived"
+	// EventQualityEncoding is sent when a quality started encoding
+	EventQualityEncoding StatusEventType = "qualityEncoding"
+	// EventQualityEncoded is sent when a quality is encoded
+	EventQualityEncoded StatusEventType = "qualityEncoded"
+	// EventQualityFailed is sent when a quality failed to encode
+	EventQualityFailed StatusEventType = "qualityFailed"
+	// EventPlayable is sent once the video is playable
+	EventPlayable StatusEventType = "playable"
+	// EventError is sent when the status of a video could not be retrieved
+	EventError StatusEventType = "error"
+)
+
+// StatusEvent is a change of the status of a video, see VideosService.WatchStatus
+type StatusEvent struct {
+	Type    StatusEventType
+	VideoID string
+	// Quality is the quality concerned by the EventQuality* events
+	Quality QualityName
+	// ReceivedBytes and Filesize are set on EventBytesReceived events
+	ReceivedBytes int64
+	Filesize      int64
+	// Status is the status the event was detected in, nil on EventError events
+	Status *VideoStatus
+	// Err is set on EventError events
+	Err error
+}
+
+// WatchOpts configures VideosService.WatchStatus
+type WatchOpts struct {
+	// PollInterval is the delay between two status requests of a video
+	// after a change, 2s by default
+	PollInterval time.Duration
+	// MaxPollInterval caps the delay between two status requests, which doubles
+	// while the status of the video does not change, 30s by default
+	MaxPollInterval time.Duration
+}
+
+// videoWatch is the last known state of a watched video
+type videoWatch struct {
+	videoID       string
+	ingestStarted bool
+	receivedBytes int64
+	qualities     map[QualityName]QualityStatus
+	playable      bool
+}
+
+//WatchStatus polls the status of the given videos and sends an event on the returned
+//channel for every change: ingest started, bytes received, quality encoding, encoded
+//or failed and video playable. Unchanged states are not sent again.
+//A video is no longer watched once it is playable and all its qualities are encoded or failed.
+//The channel is closed when no video is watched anymore or when ctx is done
+func (s *VideosService) WatchStatus(ctx context.Context, videoIDs []string, opts *WatchOpts) (<-chan StatusEvent, error) {
+
+	for _, videoID := range videoIDs {
+		err := checkVideoID(videoID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	o := WatchOpts{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPollInterval
+	}
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = defaultMaxPollInterval
+	}
+
+	events := make(chan StatusEvent)
+
+	var wg sync.WaitGroup
+	for _, videoID := range videoIDs {
+		wg.Add(1)
+		go func(videoID string) {
+			defer wg.Done()
+			s.watch(ctx, &videoWatch{videoID: videoID, qualities: map[QualityName]QualityStatus{}}, o, events)
+		}(videoID)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events, nil
+}
+
+// watch polls the status of one video until it is complete or ctx is done
+func (s *VideosService) watch(ctx context.Context, w *videoWatch, o WatchOpts, events chan<- StatusEvent) {
+	interval := o.PollInterval
+	for {
+		status, err := s.StatusWithContext(ctx, w.videoID)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var changes []StatusEvent
+		if err != nil {
+			changes = []StatusEvent{{Type: EventError, VideoID: w.videoID, Err: err}}
+		} else {
+			changes = w.update(status)
+		}
+
+		for _, event := range changes {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err != nil && isClientError(err) {
+			// The video does not exist or cannot be read, polling again will not help
+			return
+		}
+		if err == nil && w.complete(status) {
+			return
+		}
+
+		if len(changes) > 0 && err == nil {
+			interval = o.PollInterval
+		} else {
+			interval *= 2
+			if interval > o.MaxPollInterval {
+				interval = o.MaxPollInterval
+			}
+		}
+
+		if sleepContext(ctx, interval) != nil {
+			return
+		}
+	}
+}
+
+// update records status and returns the events of the changes since the previous one
+func (w *videoWatch) update(status *VideoStatus) []StatusEvent {
+	var events []StatusEvent
+	event := func(t StatusEventType) StatusEvent {
+		return StatusEvent{Type: t, VideoID: w.videoID, Status: status}
+	}
+
+	if ingest := status.Ingest; ingest != nil {
+		if !w.ingestStarted && ingest.Status != "" {
+			w.ingestStarted = true
+			events = append(events, event(EventIngestStarted))
+		}
+
+		var received int64
+		for _, item := range ingest.ReceivedBytes {
+			received += int64(item.To-item.From) + 1
+		}
+		if received != w.receivedBytes {
+			w.receivedBytes = received
+			e := event(EventBytesReceived)
+			e.ReceivedBytes = received
+			e.Filesize = int64(ingest.Filesize)
+			events = append(events, e)
+		}
+	}
+
+	if encoding := status.Encoding; encoding != nil {
+		for _, q := range encoding.Qualities {
+			if w.qualities[q.Quality] == q.Status {
+				continue
+			}
+			w.qualities[q.Quality] = q.Status
+
+			var t StatusEventType
+			switch q.Status {
+			case QualityEncoding:
+				t = EventQualityEncoding
+			case QualityEncoded:
+				t = EventQualityEncoded
+			case QualityFailed:
+				t = EventQualityFailed
+			default:
+				continue
+			}
+			e := event(t)
+			e.Quality = q.Quality
+			events = append(events, e)
+		}
+
+		if !w.playable && encoding.Playable {
+			w.playable = true
+			events = append(events, event(EventPlayable))
+		}
+	}
+
+	return events
+}
+
+// complete reports whether the video will not change anymore
+func (w *videoWatch) complete(status *VideoStatus) bool {
+	if status.Encoding == nil || !status.Encoding.Playable || len(status.Encoding.Qualities) == 0 {
+		return false
+	}
+	for _, q := range status.Encoding.Qualities {
+		if q.Status != QualityEncoded && q.Status != QualityFailed {
+			return false
+		}
+	}
+	return true
+}
+
+func isClientError(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	code := errResp.Response.StatusCode
+	return code >= 400 && code < 500 && code != http.StatusTooManyRequests
+}
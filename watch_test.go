@@ -0,0 +1,95 @@
+package apivideosdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestVideos_WatchStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	first, _ := statusSequence(
+		`{"ingest": {"status": "uploading", "filesize": 100, "receivedBytes": [{"from": 0, "to": 49, "total": 100}]}}`,
+		`{"ingest": {"status": "uploading", "filesize": 100, "receivedBytes": [{"from": 0, "to": 49, "total": 100}]}}`,
+		`{"ingest": {"status": "uploaded", "filesize": 100, "receivedBytes": [{"from": 0, "to": 99, "total": 100}]},
+		  "encoding": {"playable": false, "qualities": [{"quality": "360p", "status": "encoding"}, {"quality": "720p", "status": "waiting"}]}}`,
+		`{"ingest": {"status": "uploaded", "filesize": 100, "receivedBytes": [{"from": 0, "to": 99, "total": 100}]},
+		  "encoding": {"playable": true, "qualities": [{"quality": "360p", "status": "encoded"}, {"quality": "720p", "status": "failed"}]}}`,
+	)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", first)
+	mux.HandleFunc("/videos/vi6HangYsow3vXxwdx3YMlAb/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	events, err := client.Videos.WatchStatus(context.Background(), []string{"vi4k0jvEUuaTdRAEjQ4Jfagz", "vi6HangYsow3vXxwdx3YMlAb"}, &WatchOpts{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Videos.WatchStatus error: %v", err)
+	}
+
+	type summary struct {
+		Type          StatusEventType
+		Quality       QualityName
+		ReceivedBytes int64
+	}
+	var got []summary
+	errorEvents := 0
+	for event := range events {
+		if event.VideoID == "vi6HangYsow3vXxwdx3YMlAb" {
+			if event.Type != EventError || event.Err == nil {
+				t.Errorf("Videos.WatchStatus should report the error of the unknown video, got %#v", event)
+			}
+			errorEvents++
+			continue
+		}
+		got = append(got, summary{event.Type, event.Quality, event.ReceivedBytes})
+	}
+
+	expected := []summary{
+		{EventIngestStarted, "", 0},
+		{EventBytesReceived, "", 50},
+		{EventBytesReceived, "", 100},
+		{EventQualityEncoding, "360p", 0},
+		{EventQualityEncoded, "360p", 0},
+		{EventQualityFailed, "720p", 0},
+		{EventPlayable, "", 0},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Videos.WatchStatus events\n got=%#v\nwant=%#v", got, expected)
+	}
+	if errorEvents != 1 {
+		t.Errorf("Videos.WatchStatus should stop watching an unknown video, got %d errors", errorEvents)
+	}
+}
+
+func TestVideos_WatchStatusCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler, _ := statusSequence(`{"encoding": {"playable": false}}`)
+	mux.HandleFunc("/videos/vi4k0jvEUuaTdRAEjQ4Jfagz/status", handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := client.Videos.WatchStatus(ctx, []string{"vi4k0jvEUuaTdRAEjQ4Jfagz"}, &WatchOpts{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Videos.WatchStatus error: %v", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("Videos.WatchStatus should not send events for an unchanged status")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Videos.WatchStatus should close the channel once the context is done")
+	}
+
+	_, err = client.Videos.WatchStatus(ctx, []string{"invalid"}, nil)
+	if err == nil {
+		t.Errorf("Videos.WatchStatus should reject invalid video ids")
+	}
+}